@@ -0,0 +1,97 @@
+package inflection
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+func AddAcronym(acronyms ...string) {
+	defaultInflector.AddAcronym(acronyms...)
+}
+
+// AddAcronym registers words such as "API", "URL" or "RESTful" whose casing
+// must survive inflection and case conversion: with "API" registered,
+// Pluralize("API") is "APIs" rather than "APIS" and Camelize("api_key") is
+// "APIKey".
+func (in *Inflector) AddAcronym(acronyms ...string) {
+	in.mu.Lock()
+	defer in.mu.Unlock()
+
+	if in.acronyms == nil {
+		in.acronyms = make(map[string]string)
+	}
+
+	for _, acronym := range acronyms {
+		in.acronyms[strings.ToLower(acronym)] = acronym
+	}
+
+	if in.cache != nil {
+		in.cache.purge()
+	}
+}
+
+// acronym returns the registered spelling of word, which may be an acronym
+// in any case or its plural.
+func (in *Inflector) acronym(word string) (string, bool) {
+	lower := strings.ToLower(word)
+
+	if acronym, ok := in.acronyms[lower]; ok {
+		return acronym, true
+	}
+
+	if singular := strings.TrimSuffix(lower, "s"); singular != lower {
+		if acronym, ok := in.acronyms[singular]; ok {
+			return acronym + "s", true
+		}
+	}
+
+	return "", false
+}
+
+// acronymAt returns the length of the longest registered acronym, or plural
+// acronym, spelled exactly as registered at runes[i:] and not running into a
+// lowercase letter.
+func (in *Inflector) acronymAt(runes []rune, i int) int {
+	longest := 0
+
+	for _, acronym := range in.acronyms {
+		word := []rune(acronym)
+		if len(word) > len(runes)-i || string(runes[i:i+len(word)]) != acronym {
+			continue
+		}
+
+		n := len(word)
+		if i+n < len(runes) && runes[i+n] == 's' && (i+n+1 == len(runes) || !unicode.IsLower(runes[i+n+1])) {
+			n++
+		} else if i+n < len(runes) && unicode.IsLower(runes[i+n]) {
+			continue
+		}
+
+		if n > longest {
+			longest = n
+		}
+	}
+
+	return longest
+}
+
+// endsWithAcronym reports whether noun ends with a registered acronym followed
+// by suffix, and the acronym starts a word: "API", "user_API" or "UserAPI".
+func (in *Inflector) endsWithAcronym(noun, suffix string) bool {
+	for _, acronym := range in.acronyms {
+		word := acronym + suffix
+		if !strings.HasSuffix(noun, word) {
+			continue
+		}
+
+		prefix := noun[:len(noun)-len(word)]
+		r, _ := utf8.DecodeLastRuneInString(prefix)
+
+		if prefix == "" || isWordDelimiter(r) || r == '.' || unicode.IsLower(r) || unicode.IsDigit(r) {
+			return true
+		}
+	}
+
+	return false
+}
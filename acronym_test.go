@@ -0,0 +1,59 @@
+package inflection_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tjimsk/inflection"
+)
+
+func TestAcronyms(t *testing.T) {
+	in := inflection.New()
+	in.AddAcronym("API", "URL", "HTTP", "RESTful", "ID")
+
+	type testData struct {
+		singular string
+		plural   string
+	}
+
+	data := []testData{
+		testData{"API", "APIs"},
+		testData{"URL", "URLs"},
+		testData{"user_API", "user_APIs"},
+		testData{"UserAPI", "UserAPIs"},
+		testData{"legacy.URL", "legacy.URLs"},
+		testData{"ID", "IDs"},
+		testData{"person", "people"},
+		testData{"STAR", "STARS"},
+	}
+
+	for _, td := range data {
+		assert.Equal(t, td.plural, in.Pluralize(td.singular), "wrong plural for %v", td.singular)
+		assert.Equal(t, td.singular, in.Singularize(td.plural), "wrong singular for %v", td.plural)
+		assert.Equal(t, td.plural, in.Pluralize(td.plural), "wrong plural for %v", td.plural)
+	}
+
+	assert.True(t, in.IsPlural("APIs"))
+	assert.False(t, in.IsPlural("API"))
+	assert.Equal(t, "APIS", inflection.Pluralize("API"))
+
+	assert.Equal(t, "APIKey", in.Camelize("api_key"))
+	assert.Equal(t, "apiKey", in.CamelizeLower("api_key"))
+	assert.Equal(t, "UserAPIs", in.Camelize("user_apis"))
+	assert.Equal(t, "HTTPRequestURL", in.Camelize("http_request_url"))
+	assert.Equal(t, "RESTfulController", in.Camelize("restful_controller"))
+	assert.Equal(t, "UserID", in.Camelize("user_id"))
+	assert.Equal(t, "Identity", in.Camelize("identity"))
+
+	assert.Equal(t, "restful_controller", in.Underscore("RESTfulController"))
+	assert.Equal(t, "user_apis", in.Underscore("UserAPIs"))
+	assert.Equal(t, "http_api_key", in.Underscore("HTTPAPIKey"))
+	assert.Equal(t, "parse-url", in.Dasherize("parseURL"))
+	assert.Equal(t, "res_tful_controller", inflection.Underscore("RESTfulController"))
+
+	assert.Equal(t, "HTTPRequest", in.Classify(in.Tableize("HTTPRequest")))
+	assert.Equal(t, "http_requests", in.Tableize("HTTPRequest"))
+	assert.Equal(t, "api_keys", in.Tableize("APIKey"))
+	assert.Equal(t, "user_apis", in.Tableize("UserAPI"))
+	assert.Equal(t, "UserAPI", in.Classify("user_apis"))
+}
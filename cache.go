@@ -0,0 +1,93 @@
+package inflection
+
+import (
+	"container/list"
+	"sync"
+)
+
+type CacheStatistics struct {
+	Hits     uint64
+	Misses   uint64
+	Entries  int
+	Capacity int
+}
+
+type cacheKey struct {
+	plural bool
+	gender Gender
+	word   string
+}
+
+type cacheEntry struct {
+	key   cacheKey
+	value string
+}
+
+// lruCache is a bounded, least-recently-used memo of inflection results that
+// is safe for concurrent use.
+type lruCache struct {
+	mu       sync.Mutex
+	capacity int
+	entries  map[cacheKey]*list.Element
+	order    *list.List
+	hits     uint64
+	misses   uint64
+}
+
+func newLRUCache(capacity int) *lruCache {
+	return &lruCache{
+		capacity: capacity,
+		entries:  make(map[cacheKey]*list.Element),
+		order:    list.New(),
+	}
+}
+
+func (c *lruCache) get(key cacheKey) (string, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	e, ok := c.entries[key]
+	if !ok {
+		c.misses++
+		return "", false
+	}
+
+	c.hits++
+	c.order.MoveToFront(e)
+
+	return e.Value.(*cacheEntry).value, true
+}
+
+func (c *lruCache) put(key cacheKey, value string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if e, ok := c.entries[key]; ok {
+		e.Value.(*cacheEntry).value = value
+		c.order.MoveToFront(e)
+		return
+	}
+
+	c.entries[key] = c.order.PushFront(&cacheEntry{key: key, value: value})
+
+	if c.order.Len() > c.capacity {
+		oldest := c.order.Back()
+		c.order.Remove(oldest)
+		delete(c.entries, oldest.Value.(*cacheEntry).key)
+	}
+}
+
+func (c *lruCache) purge() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.entries = make(map[cacheKey]*list.Element)
+	c.order.Init()
+}
+
+func (c *lruCache) stats() CacheStatistics {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return CacheStatistics{Hits: c.hits, Misses: c.misses, Entries: c.order.Len(), Capacity: c.capacity}
+}
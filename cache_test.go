@@ -0,0 +1,63 @@
+package inflection_test
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tjimsk/inflection"
+)
+
+func TestCache(t *testing.T) {
+	in := inflection.New()
+	assert.Equal(t, inflection.CacheStatistics{}, in.CacheStats())
+
+	in.EnableCache(2)
+	assert.Equal(t, "people", in.Pluralize("person"))
+	assert.Equal(t, "people", in.Pluralize("person"))
+	assert.Equal(t, "person", in.Singularize("people"))
+	assert.Equal(t, inflection.CacheStatistics{Hits: 1, Misses: 2, Entries: 2, Capacity: 2}, in.CacheStats())
+
+	assert.Equal(t, "stars", in.Pluralize("star"))
+	assert.Equal(t, "people", in.Pluralize("person"))
+	stats := in.CacheStats()
+	assert.Equal(t, uint64(4), stats.Misses)
+	assert.Equal(t, 2, stats.Entries)
+
+	assert.NoError(t, in.AddIrregular("star", "starlings"))
+	assert.Equal(t, 0, in.CacheStats().Entries)
+	assert.Equal(t, "starlings", in.Pluralize("star"))
+
+	in.EnableCache(0)
+	assert.Equal(t, inflection.CacheStatistics{}, in.CacheStats())
+	assert.Equal(t, "starlings", in.Pluralize("star"))
+
+	assert.Equal(t, inflection.CacheStatistics{}, inflection.CacheStats(), "the default inflector has no cache")
+}
+
+func TestCacheConcurrentUse(t *testing.T) {
+	in := inflection.New()
+	in.EnableCache(16)
+
+	words := []string{"person", "child", "status", "query", "index", "mouse"}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			for j := 0; j < 200; j++ {
+				word := words[(i+j)%len(words)]
+				assert.Equal(t, word, in.Singularize(in.Pluralize(word)))
+				if j == 100 {
+					assert.NoError(t, in.AddUncountable("metadata"))
+				}
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	stats := in.CacheStats()
+	assert.NotZero(t, stats.Hits)
+	assert.True(t, stats.Entries <= 16)
+}
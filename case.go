@@ -0,0 +1,300 @@
+package inflection
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+func Camelize(s string) string {
+	return defaultInflector.Camelize(s)
+}
+
+func CamelizeLower(s string) string {
+	return defaultInflector.CamelizeLower(s)
+}
+
+func Underscore(s string) string {
+	return defaultInflector.Underscore(s)
+}
+
+func Dasherize(s string) string {
+	return defaultInflector.Dasherize(s)
+}
+
+func Humanize(s string) string {
+	return defaultInflector.Humanize(s)
+}
+
+func Titleize(s string) string {
+	return defaultInflector.Titleize(s)
+}
+
+func Tableize(name string) string {
+	return defaultInflector.Tableize(name)
+}
+
+func Classify(table string) string {
+	return defaultInflector.Classify(table)
+}
+
+// Camelize converts an identifier such as "user_profile" or "user-profile"
+// to "UserProfile". Runs of capitals are kept, so "http_server" and
+// "HTTPServer" become "HttpServer" and "HTTPServer" respectively, unless
+// "HTTP" is a registered acronym.
+func (in *Inflector) Camelize(s string) string {
+	in.mu.RLock()
+	defer in.mu.RUnlock()
+
+	var b strings.Builder
+
+	for _, word := range in.splitWords(s) {
+		b.WriteString(in.capitalize(word))
+	}
+
+	return b.String()
+}
+
+// CamelizeLower is Camelize with a lowercase first word: "userProfile".
+func (in *Inflector) CamelizeLower(s string) string {
+	in.mu.RLock()
+	defer in.mu.RUnlock()
+
+	words := in.splitWords(s)
+	if len(words) == 0 {
+		return ""
+	}
+
+	var b strings.Builder
+
+	b.WriteString(strings.ToLower(words[0]))
+	for _, word := range words[1:] {
+		b.WriteString(in.capitalize(word))
+	}
+
+	return b.String()
+}
+
+// Underscore converts an identifier to snake case: "UserProfile" becomes
+// "user_profile" and "HTTPServer" becomes "http_server".
+func (in *Inflector) Underscore(s string) string {
+	in.mu.RLock()
+	defer in.mu.RUnlock()
+
+	return joinLower(in.splitWords(s), "_")
+}
+
+// Dasherize converts an identifier to kebab case: "user-profile".
+func (in *Inflector) Dasherize(s string) string {
+	in.mu.RLock()
+	defer in.mu.RUnlock()
+
+	return joinLower(in.splitWords(s), "-")
+}
+
+// Humanize turns an identifier into a phrase for display: it drops a trailing
+// "_id", lowercases every word but registered acronyms and capitalizes the
+// first, so "author_id" becomes "Author" and "api_key" becomes "API key".
+func (in *Inflector) Humanize(s string) string {
+	in.mu.RLock()
+	defer in.mu.RUnlock()
+
+	words := in.humanWords(s)
+	if len(words) > 0 {
+		words[0] = in.capitalize(words[0])
+	}
+
+	return strings.Join(words, " ")
+}
+
+// Titleize is Humanize with every word capitalized except small words such
+// as "of" or "the" in the middle of the title: "x_men_origins" becomes
+// "X Men Origins" and "lord_of_the_rings" becomes "Lord of the Rings".
+func (in *Inflector) Titleize(s string) string {
+	in.mu.RLock()
+	defer in.mu.RUnlock()
+
+	words := in.humanWords(s)
+	for i, word := range words {
+		if i == 0 || i == len(words)-1 || !in.smallWords[word] {
+			words[i] = in.capitalize(word)
+		}
+	}
+
+	return strings.Join(words, " ")
+}
+
+// SetSmallWords replaces the words that Titleize keeps lowercase.
+func (in *Inflector) SetSmallWords(words ...string) {
+	in.mu.Lock()
+	defer in.mu.Unlock()
+
+	in.smallWords = make(map[string]bool)
+	for _, word := range words {
+		in.smallWords[strings.ToLower(word)] = true
+	}
+}
+
+func (in *Inflector) humanWords(s string) []string {
+	words := in.splitWords(s)
+	if len(words) > 1 && strings.EqualFold(words[len(words)-1], "id") {
+		words = words[:len(words)-1]
+	}
+
+	for i, word := range words {
+		if acronym, ok := in.acronym(word); ok {
+			words[i] = acronym
+		} else {
+			words[i] = strings.ToLower(word)
+		}
+	}
+
+	return words
+}
+
+// Tableize converts a type name to a table name by underscoring it and
+// pluralizing its final word: "UserProfile" becomes "user_profiles". A schema
+// qualifier such as "public." is kept as is.
+func (in *Inflector) Tableize(name string) string {
+	schema, name := splitSchema(name)
+
+	return schema + inflectLastWord(in.Underscore(name), in.Pluralize)
+}
+
+// Classify converts a table name to a type name by singularizing its final
+// word and camelizing it: "public.user_profiles" becomes "UserProfile".
+func (in *Inflector) Classify(table string) string {
+	_, table = splitSchema(table)
+
+	return in.Camelize(inflectLastWord(in.Underscore(table), in.Singularize))
+}
+
+func splitSchema(name string) (schema, rest string) {
+	i := strings.LastIndexByte(name, '.')
+
+	return name[:i+1], name[i+1:]
+}
+
+func inflectLastWord(s string, inflect func(string) string) string {
+	i := strings.LastIndexByte(s, '_')
+
+	return s[:i+1] + inflect(s[i+1:])
+}
+
+func joinLower(words []string, sep string) string {
+	for i, word := range words {
+		words[i] = strings.ToLower(word)
+	}
+
+	return strings.Join(words, sep)
+}
+
+func (in *Inflector) capitalize(word string) string {
+	if acronym, ok := in.acronym(word); ok {
+		return acronym
+	}
+
+	r, size := utf8.DecodeRuneInString(word)
+	if r == utf8.RuneError {
+		return word
+	}
+
+	return string(unicode.ToUpper(r)) + word[size:]
+}
+
+// restoreCase carries the case of noun over to its inflection, rune by rune,
+// so that "STAR", "Person", "McDonald" and "Café" come back as "STARS",
+// "People", "McDonalds" and "Cafés". Runes beyond the end of noun take the
+// case of its last cased rune.
+func restoreCase(noun, inflection string) string {
+	in, out := []rune(noun), []rune(inflection)
+	upper := false
+
+	for i := range out {
+		if i < len(in) && (unicode.IsUpper(in[i]) || unicode.IsLower(in[i])) {
+			upper = unicode.IsUpper(in[i])
+		} else if i < len(in) {
+			continue
+		}
+
+		if upper {
+			out[i] = unicode.ToUpper(out[i])
+		}
+	}
+
+	return string(out)
+}
+
+func isWordDelimiter(r rune) bool {
+	return r == '_' || r == '-' || unicode.IsSpace(r)
+}
+
+// splitWords breaks an identifier into words at delimiters and at changes of
+// case. Registered acronyms are kept whole; any other run of capitals is one
+// word, except for its last capital when that starts a capitalized word:
+// "HTTPServer" is split into "HTTP" and "Server".
+func (in *Inflector) splitWords(s string) []string {
+	var words []string
+
+	runes := []rune(s)
+	start := -1
+
+	for i := 0; i < len(runes); i++ {
+		if isWordDelimiter(runes[i]) {
+			if start >= 0 {
+				words = append(words, string(runes[start:i]))
+				start = -1
+			}
+			continue
+		}
+
+		if start < 0 || isWordBoundary(runes, i) {
+			if n := in.acronymAt(runes, i); n > 0 {
+				if start >= 0 {
+					words = append(words, string(runes[start:i]))
+				}
+				words = append(words, string(runes[i:i+n]))
+				start = -1
+				i += n - 1
+				continue
+			}
+		}
+
+		if start >= 0 && isWordBoundary(runes, i) {
+			words = append(words, string(runes[start:i]))
+			start = i
+		}
+
+		if start < 0 {
+			start = i
+		}
+	}
+
+	if start >= 0 {
+		words = append(words, string(runes[start:]))
+	}
+
+	return words
+}
+
+func isWordBoundary(runes []rune, i int) bool {
+	if i == 0 {
+		return true
+	}
+
+	prev, r := runes[i-1], runes[i]
+
+	if isWordDelimiter(prev) {
+		return true
+	}
+
+	if !unicode.IsUpper(r) {
+		return false
+	}
+
+	if unicode.IsLower(prev) || unicode.IsDigit(prev) {
+		return true
+	}
+
+	return unicode.IsUpper(prev) && i+1 < len(runes) && unicode.IsLower(runes[i+1])
+}
@@ -0,0 +1,129 @@
+package inflection_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tjimsk/inflection"
+)
+
+func TestCaseConversions(t *testing.T) {
+	type testData struct {
+		input      string
+		camel      string
+		lowerCamel string
+		underscore string
+		dasherize  string
+	}
+
+	data := []testData{
+		testData{"user_profile", "UserProfile", "userProfile", "user_profile", "user-profile"},
+		testData{"UserProfile", "UserProfile", "userProfile", "user_profile", "user-profile"},
+		testData{"userProfile", "UserProfile", "userProfile", "user_profile", "user-profile"},
+		testData{"user-profile", "UserProfile", "userProfile", "user_profile", "user-profile"},
+		testData{"user profile", "UserProfile", "userProfile", "user_profile", "user-profile"},
+		testData{"node_child", "NodeChild", "nodeChild", "node_child", "node-child"},
+		testData{"HTTPServer", "HTTPServer", "httpServer", "http_server", "http-server"},
+		testData{"http_server", "HttpServer", "httpServer", "http_server", "http-server"},
+		testData{"parseURL", "ParseURL", "parseURL", "parse_url", "parse-url"},
+		testData{"UserID", "UserID", "userID", "user_id", "user-id"},
+		testData{"area51", "Area51", "area51", "area51", "area51"},
+		testData{"version2Name", "Version2Name", "version2Name", "version2_name", "version2-name"},
+		testData{"__private__field", "PrivateField", "privateField", "private_field", "private-field"},
+		testData{"épéeFencer", "ÉpéeFencer", "épéeFencer", "épée_fencer", "épée-fencer"},
+		testData{"A", "A", "a", "a", "a"},
+		testData{"", "", "", "", ""},
+	}
+
+	for _, td := range data {
+		assert.Equal(t, td.camel, inflection.Camelize(td.input), "Camelize(%q)", td.input)
+		assert.Equal(t, td.lowerCamel, inflection.CamelizeLower(td.input), "CamelizeLower(%q)", td.input)
+		assert.Equal(t, td.underscore, inflection.Underscore(td.input), "Underscore(%q)", td.input)
+		assert.Equal(t, td.dasherize, inflection.Dasherize(td.input), "Dasherize(%q)", td.input)
+	}
+}
+
+func TestCaseConversionRoundTrip(t *testing.T) {
+	for _, word := range []string{"user_profile", "node_children", "http_server_config"} {
+		assert.Equal(t, word, inflection.Underscore(inflection.Camelize(word)))
+		assert.Equal(t, word, inflection.Underscore(inflection.CamelizeLower(word)))
+	}
+}
+
+func TestTableizeAndClassify(t *testing.T) {
+	type testData struct {
+		class string
+		table string
+	}
+
+	data := []testData{
+		testData{"UserProfile", "user_profiles"},
+		testData{"User", "users"},
+		testData{"Person", "people"},
+		testData{"SalesPerson", "sales_people"},
+		testData{"NodeChild", "node_children"},
+		testData{"Category", "categories"},
+		testData{"Equipment", "equipment"},
+		testData{"OldNews", "old_news"},
+		testData{"Status", "statuses"},
+		testData{"Mouse", "mice"},
+		testData{"AddressBookEntry", "address_book_entries"},
+	}
+
+	for _, td := range data {
+		assert.Equal(t, td.table, inflection.Tableize(td.class), "Tableize(%q)", td.class)
+		assert.Equal(t, td.class, inflection.Classify(inflection.Tableize(td.class)), "Classify(Tableize(%q))", td.class)
+	}
+
+	assert.Equal(t, "UserProfile", inflection.Classify("user_profiles"))
+	assert.Equal(t, "UserProfile", inflection.Classify("public.user_profiles"))
+	assert.Equal(t, "UserProfile", inflection.Classify("analytics.public.user_profiles"))
+	assert.Equal(t, "public.user_profiles", inflection.Tableize("public.UserProfile"))
+	assert.Equal(t, "public.user_profiles", inflection.Tableize("public.user_profile"))
+	assert.Equal(t, "http_requests", inflection.Tableize("HTTPRequest"))
+	assert.Equal(t, "Person", inflection.Classify("people"))
+	assert.Equal(t, "people", inflection.Tableize("people"))
+	assert.Equal(t, "", inflection.Tableize(""))
+	assert.Equal(t, "", inflection.Classify(""))
+}
+
+func TestHumanizeAndTitleize(t *testing.T) {
+	type testData struct {
+		input    string
+		humanize string
+		titleize string
+	}
+
+	data := []testData{
+		testData{"author_id", "Author", "Author"},
+		testData{"AuthorID", "Author", "Author"},
+		testData{"id", "Id", "Id"},
+		testData{"employee_salary", "Employee salary", "Employee Salary"},
+		testData{"x_men_origins", "X men origins", "X Men Origins"},
+		testData{"XMenOrigins", "X men origins", "X Men Origins"},
+		testData{"lord_of_the_rings", "Lord of the rings", "Lord of the Rings"},
+		testData{"the_end_of", "The end of", "The End Of"},
+		testData{"created-at", "Created at", "Created At"},
+		testData{"  full   name ", "Full name", "Full Name"},
+		testData{"café_owner", "Café owner", "Café Owner"},
+		testData{"", "", ""},
+	}
+
+	for _, td := range data {
+		assert.Equal(t, td.humanize, inflection.Humanize(td.input), "Humanize(%q)", td.input)
+		assert.Equal(t, td.titleize, inflection.Titleize(td.input), "Titleize(%q)", td.input)
+	}
+
+	in := inflection.New()
+	in.AddAcronym("API", "HTML")
+	assert.Equal(t, "API key", in.Humanize("api_key"))
+	assert.Equal(t, "Raw HTML body", in.Humanize("raw_html_body"))
+	assert.Equal(t, "The API of HTML", in.Titleize("the_api_of_html"))
+
+	in.SetSmallWords("with")
+	assert.Equal(t, "Lord Of The Rings", in.Titleize("lord_of_the_rings"))
+	assert.Equal(t, "Coffee with Milk", in.Titleize("coffee_with_milk"))
+
+	in.SetSmallWords()
+	assert.Equal(t, "Coffee With Milk", in.Titleize("coffee_with_milk"))
+}
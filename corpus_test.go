@@ -0,0 +1,132 @@
+package inflection_test
+
+import (
+	_ "embed"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/tjimsk/inflection"
+)
+
+// englishNouns holds a few thousand nouns with their plurals. The pairs that
+// the English rules got wrong when the corpus was added are kept in
+// englishNounGaps: any other pair that goes wrong fails the test, and fixing
+// one shows up as a change to that file.
+//
+//go:embed testdata/english_nouns.txt
+var englishNouns string
+
+//go:embed testdata/english_nouns_gaps.txt
+var englishNounGaps string
+
+const englishNounGapsFile = "testdata/english_nouns_gaps.txt"
+
+var updateGaps = flag.Bool("update-gaps", false, "drop the fixed gaps from "+englishNounGapsFile)
+
+// corpusCheck inflects a word of a pair and returns the result, which should
+// be want.
+type corpusCheck struct {
+	name    string
+	inflect func(in *inflection.Inflector, singular, plural string) (word, got, want string)
+}
+
+var corpusChecks = []corpusCheck{
+	{"pluralize", func(in *inflection.Inflector, singular, plural string) (string, string, string) {
+		return singular, in.Pluralize(singular), plural
+	}},
+	{"singularize", func(in *inflection.Inflector, singular, plural string) (string, string, string) {
+		return plural, in.Singularize(plural), singular
+	}},
+	{"singular-round-trip", func(in *inflection.Inflector, singular, plural string) (string, string, string) {
+		return singular, in.Singularize(in.Pluralize(singular)), singular
+	}},
+	{"plural-round-trip", func(in *inflection.Inflector, singular, plural string) (string, string, string) {
+		return plural, in.Pluralize(in.Singularize(plural)), plural
+	}},
+}
+
+func TestEnglishCorpus(t *testing.T) {
+	in := inflection.New()
+
+	// A gap whose wrong result changes fails the test as well, until
+	// -update-gaps records it: only pairs already listed may go wrong.
+	known := make(map[string]string)
+	for _, gap := range corpusLines(englishNounGaps) {
+		known[gapPair(gap)] = gap
+	}
+
+	var gaps []string
+	for _, check := range corpusChecks {
+		total, passed := 0, 0
+
+		for _, line := range corpusLines(englishNouns) {
+			pair := strings.Fields(line)
+			if len(pair) != 2 {
+				t.Fatalf("invalid corpus line %q", line)
+			}
+
+			total++
+			word, got, want := check.inflect(in, pair[0], pair[1])
+			if got == want {
+				passed++
+				continue
+			}
+
+			gap := fmt.Sprintf("%v %v %v", check.name, word, got)
+			switch was, ok := known[gapPair(gap)]; {
+			case !ok:
+				t.Errorf("new gap %q: fix the rules", gap)
+				continue
+			case was != gap && !*updateGaps:
+				t.Errorf("gap %q is now %q: run go test -run TestEnglishCorpus -update-gaps", was, gap)
+			}
+
+			gaps = append(gaps, gap)
+			delete(known, gapPair(gap))
+		}
+
+		t.Logf("%v: %d of %d (%.1f%%)", check.name, passed, total, 100*float64(passed)/float64(total))
+	}
+
+	if *updateGaps {
+		header := "# Pairs of testdata/english_nouns.txt that the English rules got wrong when\n" +
+			"# the corpus was added, as the check, the word checked and the wrong result.\n" +
+			"# go test -run TestEnglishCorpus -update-gaps drops the fixed gaps and\n" +
+			"# records new wrong results for the others; new pairs are never added.\n"
+		if err := os.WriteFile(englishNounGapsFile, []byte(header+strings.Join(gaps, "\n")+"\n"), 0644); err != nil {
+			t.Fatal(err)
+		}
+		return
+	}
+
+	for _, gap := range known {
+		t.Errorf("gap %q is fixed: run go test -run TestEnglishCorpus -update-gaps", gap)
+	}
+}
+
+// gapPair returns the check and the word of a gap, without the wrong result.
+func gapPair(gap string) string {
+	fields := strings.Fields(gap)
+	if len(fields) < 2 {
+		return gap
+	}
+
+	return fields[0] + " " + fields[1]
+}
+
+// corpusLines returns the lines of a corpus file that are neither blank nor
+// comments.
+func corpusLines(data string) []string {
+	var lines []string
+
+	for _, line := range strings.Split(data, "\n") {
+		if line = strings.TrimSpace(line); line != "" && !strings.HasPrefix(line, "#") {
+			lines = append(lines, line)
+		}
+	}
+
+	return lines
+}
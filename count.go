@@ -0,0 +1,52 @@
+package inflection
+
+import (
+	"math"
+	"strconv"
+)
+
+func PluralizeCount(count int, noun string) string {
+	return defaultInflector.PluralizeCount(count, noun)
+}
+
+func PluralizeForCount(count int, noun string) string {
+	return defaultInflector.PluralizeForCount(count, noun)
+}
+
+func PluralizeCountFloat(count float64, noun string) string {
+	return defaultInflector.PluralizeCountFloat(count, noun)
+}
+
+func PluralizeForCountFloat(count float64, noun string) string {
+	return defaultInflector.PluralizeForCountFloat(count, noun)
+}
+
+// PluralizeCount prefixes noun with count, pluralizing it as English does for
+// anything but one: "1 file", "0 files", "-3 files".
+func (in *Inflector) PluralizeCount(count int, noun string) string {
+	return strconv.Itoa(count) + " " + in.PluralizeForCount(count, noun)
+}
+
+// PluralizeForCount is PluralizeCount without the number.
+func (in *Inflector) PluralizeForCount(count int, noun string) string {
+	if count == 1 || count == -1 {
+		return noun
+	}
+
+	return in.Pluralize(noun)
+}
+
+// PluralizeCountFloat is PluralizeCount for a fractional count, which takes
+// the plural unless it is exactly one: "1.5 files".
+func (in *Inflector) PluralizeCountFloat(count float64, noun string) string {
+	return strconv.FormatFloat(count, 'f', -1, 64) + " " + in.PluralizeForCountFloat(count, noun)
+}
+
+// PluralizeForCountFloat is PluralizeCountFloat without the number.
+func (in *Inflector) PluralizeForCountFloat(count float64, noun string) string {
+	if math.Abs(count) == 1 {
+		return noun
+	}
+
+	return in.Pluralize(noun)
+}
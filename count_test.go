@@ -0,0 +1,61 @@
+package inflection_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tjimsk/inflection"
+)
+
+func TestPluralizeCount(t *testing.T) {
+	type testData struct {
+		count    int
+		noun     string
+		expected string
+	}
+
+	data := []testData{
+		testData{1, "file", "1 file"},
+		testData{3, "file", "3 files"},
+		testData{0, "file", "0 files"},
+		testData{-1, "degree", "-1 degree"},
+		testData{-2, "degree", "-2 degrees"},
+		testData{2, "person", "2 people"},
+		testData{1000000, "mouse", "1000000 mice"},
+		testData{12, "equipment", "12 equipment"},
+	}
+
+	for _, td := range data {
+		assert.Equal(t, td.expected, inflection.PluralizeCount(td.count, td.noun), "count %v of %v", td.count, td.noun)
+	}
+
+	assert.Equal(t, "file", inflection.PluralizeForCount(1, "file"))
+	assert.Equal(t, "files", inflection.PluralizeForCount(0, "file"))
+	assert.Equal(t, "children", inflection.New().PluralizeForCount(2, "child"))
+}
+
+func TestPluralizeCountFloat(t *testing.T) {
+	type testData struct {
+		count    float64
+		noun     string
+		expected string
+	}
+
+	data := []testData{
+		testData{1, "file", "1 file"},
+		testData{-1, "degree", "-1 degree"},
+		testData{1.5, "mile", "1.5 miles"},
+		testData{0.5, "mile", "0.5 miles"},
+		testData{1.0, "person", "1 person"},
+		testData{2, "person", "2 people"},
+		testData{math.Inf(1), "star", "+Inf stars"},
+	}
+
+	for _, td := range data {
+		assert.Equal(t, td.expected, inflection.PluralizeCountFloat(td.count, td.noun), "count %v of %v", td.count, td.noun)
+	}
+
+	assert.Equal(t, "file", inflection.PluralizeForCountFloat(1, "file"))
+	assert.Equal(t, "children", inflection.New().PluralizeForCountFloat(2.5, "child"))
+}
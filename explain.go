@@ -0,0 +1,205 @@
+package inflection
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+// Case is the shape of the letter case of a word, which Pluralize and
+// Singularize carry over to their result.
+type Case string
+
+const (
+	NoCase    Case = "no"
+	LowerCase Case = "lower"
+	UpperCase Case = "upper"
+	TitleCase Case = "title"
+	MixedCase Case = "mixed"
+)
+
+// RuleMatch is a rule that matched a word, as reported by ExplainPluralize
+// and ExplainSingularize.
+type RuleMatch struct {
+	Table Table
+	// Rule is the rule as it was added to its table.
+	Rule *Rule
+	// Pattern and Replacement are those of Rule in the direction of the
+	// inflection: for a singular rule Pattern is Rule.Plural().
+	Pattern     string
+	Replacement string
+	// Compiled is the pattern that actually matched, as compiled from Rule.
+	Compiled string
+	// Result is what the rule turns the word into.
+	Result string
+}
+
+func (m RuleMatch) String() string {
+	return fmt.Sprintf("%v rule %q => %q (compiled as %q) gives %q", m.Table, m.Pattern, m.Replacement, m.Compiled, m.Result)
+}
+
+// Explanation tells how Pluralize or Singularize inflected a word.
+type Explanation struct {
+	Word   string
+	Result string
+	Plural bool
+	Gender Gender
+	// Case is the shape of the case of Word applied to Result.
+	Case Case
+	// Applied is the rule that produced Result. It is nil when Result is due
+	// to something else, given by Reason: the word being already plural or
+	// ending in an acronym, extending an uncountable, or no rule matching
+	// it.
+	Applied *RuleMatch
+	Reason  string
+	// Others are the other rules that match Word, which Applied took
+	// precedence over, highest precedence first.
+	Others []RuleMatch
+}
+
+func (e *Explanation) String() string {
+	var b strings.Builder
+
+	verb := "singularize"
+	if e.Plural {
+		verb = "pluralize"
+	}
+
+	fmt.Fprintf(&b, "%v %q => %q, %v case", verb, e.Word, e.Result, e.Case)
+	if e.Gender != NoGender {
+		fmt.Fprintf(&b, ", %v", e.Gender)
+	}
+	b.WriteString("\n")
+
+	if e.Applied != nil {
+		fmt.Fprintf(&b, "  applied %v\n", e.Applied)
+	} else {
+		fmt.Fprintf(&b, "  %v\n", e.Reason)
+	}
+
+	for _, m := range e.Others {
+		fmt.Fprintf(&b, "  also matched %v\n", m)
+	}
+
+	return b.String()
+}
+
+func ExplainPluralize(noun string) *Explanation {
+	return defaultInflector.ExplainPluralize(noun)
+}
+
+func ExplainSingularize(noun string) *Explanation {
+	return defaultInflector.ExplainSingularize(noun)
+}
+
+func ExplainPluralizeGender(noun string, gender Gender) *Explanation {
+	return defaultInflector.ExplainPluralizeGender(noun, gender)
+}
+
+func ExplainSingularizeGender(noun string, gender Gender) *Explanation {
+	return defaultInflector.ExplainSingularizeGender(noun, gender)
+}
+
+// ExplainPluralize returns how Pluralize inflects noun: the rule applied, the
+// case carried over and every other rule that matched. It bypasses the cache.
+func (in *Inflector) ExplainPluralize(noun string) *Explanation {
+	return in.explain(noun, true, NoGender)
+}
+
+// ExplainSingularize returns how Singularize inflects noun.
+func (in *Inflector) ExplainSingularize(noun string) *Explanation {
+	return in.explain(noun, false, NoGender)
+}
+
+// ExplainPluralizeGender returns how PluralizeGender inflects noun.
+func (in *Inflector) ExplainPluralizeGender(noun string, gender Gender) *Explanation {
+	return in.explain(noun, true, gender)
+}
+
+// ExplainSingularizeGender returns how SingularizeGender inflects noun.
+func (in *Inflector) ExplainSingularizeGender(noun string, gender Gender) *Explanation {
+	return in.explain(noun, false, gender)
+}
+
+func (in *Inflector) explain(noun string, plural bool, gender Gender) *Explanation {
+	in.mu.RLock()
+	defer in.mu.RUnlock()
+
+	e := &Explanation{Word: noun, Plural: plural, Gender: gender, Case: caseOf(noun)}
+
+	rules := in.singularize
+	if plural {
+		rules = in.pluralize
+	}
+
+	for i := len(rules) - 1; i >= 0; i-- {
+		r := rules[i]
+
+		re, replacement := r.pluralRe, r.singular
+		pattern, sourceReplacement := r.source.plural, r.source.singular
+		if plural {
+			re, replacement = r.singularRe, r.plural
+			pattern, sourceReplacement = r.source.singular, r.source.plural
+		}
+
+		if !r.appliesTo(gender) || !re.MatchString(noun) {
+			continue
+		}
+
+		e.Others = append(e.Others, RuleMatch{
+			Table:       r.table,
+			Rule:        r.source,
+			Pattern:     pattern,
+			Replacement: sourceReplacement,
+			Compiled:    re.String(),
+			Result:      restoreCase(noun, re.ReplaceAllString(noun, replacement)),
+		})
+	}
+
+	switch {
+	case plural && in.isPlural(noun, gender):
+		e.Result, e.Reason = noun, "already plural"
+	case plural && in.endsWithAcronym(noun, ""):
+		e.Result, e.Reason = noun+"s", "ends with an acronym"
+	case !plural && in.endsWithAcronym(noun, "s"):
+		e.Result, e.Reason = strings.TrimSuffix(noun, "s"), "ends with an acronym"
+	case len(e.Others) == 0:
+		e.Result, e.Reason = noun, "no matching rule"
+	case !plural && in.extendsUncountable(noun, e.Others[0].Result, gender):
+		e.Result, e.Reason = noun, "extends an uncountable"
+	default:
+		applied := e.Others[0]
+		e.Applied, e.Result, e.Others = &applied, applied.Result, e.Others[1:]
+	}
+
+	return e
+}
+
+// caseOf returns the shape of the case of the cased letters of s.
+func caseOf(s string) Case {
+	upper, lower, title := 0, 0, true
+
+	for _, r := range s {
+		switch {
+		case unicode.IsUpper(r):
+			title = title && upper+lower == 0
+			upper++
+		case unicode.IsLower(r):
+			title = title && upper+lower > 0
+			lower++
+		}
+	}
+
+	switch {
+	case upper == 0 && lower == 0:
+		return NoCase
+	case upper == 0:
+		return LowerCase
+	case lower == 0:
+		return UpperCase
+	case title:
+		return TitleCase
+	}
+
+	return MixedCase
+}
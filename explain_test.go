@@ -0,0 +1,93 @@
+package inflection_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tjimsk/inflection"
+	"golang.org/x/text/language"
+)
+
+func TestExplainMatchesInflection(t *testing.T) {
+	for _, td := range inflections {
+		for _, w := range []string{td.singular, td.plural} {
+			assert.Equal(t, inflection.Pluralize(w), inflection.ExplainPluralize(w).Result, "plural of %v", w)
+			assert.Equal(t, inflection.Singularize(w), inflection.ExplainSingularize(w).Result, "singular of %v", w)
+		}
+	}
+}
+
+func TestExplain(t *testing.T) {
+	in := inflection.New()
+
+	e := in.ExplainPluralize("Quiz")
+	assert.Equal(t, "Quizzes", e.Result)
+	assert.Equal(t, inflection.TitleCase, e.Case)
+	if assert.NotNil(t, e.Applied) {
+		assert.Equal(t, inflection.PluralTable, e.Applied.Table)
+		assert.Equal(t, "(quiz)$", e.Applied.Pattern)
+		assert.Equal(t, "${1}zes", e.Applied.Replacement)
+		assert.Equal(t, "(?i)(quiz)$", e.Applied.Compiled)
+		assert.Equal(t, "(quiz)$", e.Applied.Rule.Singular())
+	}
+	if assert.NotEmpty(t, e.Others) {
+		last := e.Others[len(e.Others)-1]
+		assert.Equal(t, `(\pL)$`, last.Pattern)
+		assert.Equal(t, "Quizs", last.Result)
+	}
+	assert.Contains(t, e.String(), `pluralize "Quiz" => "Quizzes", title case`)
+	assert.Contains(t, e.String(), `applied plural rule "(quiz)$" => "${1}zes"`)
+
+	e = in.ExplainSingularize("PEOPLE")
+	assert.Equal(t, "PERSON", e.Result)
+	assert.Equal(t, inflection.UpperCase, e.Case)
+	if assert.NotNil(t, e.Applied) {
+		assert.Equal(t, inflection.IrregularTable, e.Applied.Table)
+		assert.Equal(t, "people", e.Applied.Pattern)
+		assert.Equal(t, "person", e.Applied.Replacement)
+	}
+
+	e = in.ExplainPluralize("old_rice")
+	assert.Equal(t, "old_rice", e.Result)
+	assert.Nil(t, e.Applied)
+	assert.Equal(t, "already plural", e.Reason)
+	if assert.NotEmpty(t, e.Others) {
+		assert.Equal(t, inflection.UncountableTable, e.Others[0].Table)
+		assert.Equal(t, "rice", e.Others[0].Pattern)
+	}
+
+	e = in.ExplainSingularize("informations")
+	assert.Equal(t, "informations", e.Result)
+	assert.Nil(t, e.Applied)
+	assert.Equal(t, "extends an uncountable", e.Reason)
+
+	e = in.ExplainPluralize("123")
+	assert.Equal(t, "no matching rule", e.Reason)
+	assert.Equal(t, inflection.NoCase, e.Case)
+	assert.Empty(t, e.Others)
+
+	in.AddAcronym("API")
+	e = in.ExplainPluralize("API")
+	assert.Equal(t, "APIs", e.Result)
+	assert.Equal(t, "ends with an acronym", e.Reason)
+
+	assert.Equal(t, inflection.MixedCase, in.ExplainPluralize("McDonald").Case)
+	assert.Equal(t, inflection.LowerCase, in.ExplainPluralize("star").Case)
+}
+
+func TestExplainGender(t *testing.T) {
+	de := inflection.For(language.German)
+
+	e := de.ExplainPluralizeGender("Leiter", inflection.Feminine)
+	assert.Equal(t, "Leitern", e.Result)
+	if assert.NotNil(t, e.Applied) {
+		assert.Equal(t, inflection.Feminine, e.Applied.Rule.Gender())
+	}
+	assert.Contains(t, e.String(), "feminine")
+
+	e = de.ExplainPluralizeGender("Leiter", inflection.Masculine)
+	assert.Equal(t, "Leiter", e.Result)
+	for _, m := range e.Others {
+		assert.NotEqual(t, inflection.Feminine, m.Rule.Gender())
+	}
+}
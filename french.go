@@ -0,0 +1,106 @@
+package inflection
+
+var frenchPlurals = []*Rule{
+	&Rule{singular: `(\pL)$`, plural: "${1}s"},
+	&Rule{singular: "([sxz])$", plural: "${1}"},
+	&Rule{singular: "al$", plural: "aux"},
+	&Rule{singular: "(au|eu)$", plural: "${1}x"},
+}
+
+var frenchSingulars = []*Rule{
+	&Rule{plural: "s$", singular: ""},
+	&Rule{plural: "aux$", singular: "al"},
+	&Rule{plural: "eaux$", singular: "eau"},
+	&Rule{plural: "eux$", singular: "eu"},
+	&Rule{plural: "oux$", singular: "ou"},
+}
+
+var frenchIrregulars = []*Rule{
+	&Rule{singular: "aïeul", plural: "aïeux"},
+	&Rule{singular: "bail", plural: "baux"},
+	&Rule{singular: "bal", plural: "bals"},
+	&Rule{singular: "bijou", plural: "bijoux"},
+	&Rule{singular: "bleu", plural: "bleus"},
+	&Rule{singular: "bonhomme", plural: "bonshommes"},
+	&Rule{singular: "boyau", plural: "boyaux"},
+	&Rule{singular: "caillou", plural: "cailloux"},
+	&Rule{singular: "carnaval", plural: "carnavals"},
+	&Rule{singular: "cérémonial", plural: "cérémonials"},
+	&Rule{singular: "chacal", plural: "chacals"},
+	&Rule{singular: "chou", plural: "choux"},
+	&Rule{singular: "ciel", plural: "cieux"},
+	&Rule{singular: "corail", plural: "coraux"},
+	&Rule{singular: "émail", plural: "émaux"},
+	&Rule{singular: "émeu", plural: "émeus"},
+	&Rule{singular: "étau", plural: "étaux"},
+	&Rule{singular: "festival", plural: "festivals"},
+	&Rule{singular: "genou", plural: "genoux"},
+	&Rule{singular: "gentilhomme", plural: "gentilshommes"},
+	&Rule{singular: "hibou", plural: "hiboux"},
+	&Rule{singular: "joujou", plural: "joujoux"},
+	&Rule{singular: "joyau", plural: "joyaux"},
+	&Rule{singular: "landau", plural: "landaus"},
+	&Rule{singular: "madame", plural: "mesdames"},
+	&Rule{singular: "mademoiselle", plural: "mesdemoiselles"},
+	&Rule{singular: "monsieur", plural: "messieurs"},
+	&Rule{singular: "noyau", plural: "noyaux"},
+	&Rule{singular: "œil", plural: "yeux"},
+	&Rule{singular: "pneu", plural: "pneus"},
+	&Rule{singular: "pou", plural: "poux"},
+	&Rule{singular: "récital", plural: "récitals"},
+	&Rule{singular: "régal", plural: "régals"},
+	&Rule{singular: "sarrau", plural: "sarraus"},
+	&Rule{singular: "soupirail", plural: "soupiraux"},
+	&Rule{singular: "travail", plural: "travaux"},
+	&Rule{singular: "tuyau", plural: "tuyaux"},
+	&Rule{singular: "vantail", plural: "vantaux"},
+	&Rule{singular: "vitrail", plural: "vitraux"},
+}
+
+// French nouns ending in s, x or z do not change in the plural, so the common
+// ones are listed here to keep them from being singularized.
+var frenchUncountables = []*Rule{
+	&Rule{singular: "accès", plural: "accès"},
+	&Rule{singular: "ananas", plural: "ananas"},
+	&Rule{singular: "avis", plural: "avis"},
+	&Rule{singular: "bois", plural: "bois"},
+	&Rule{singular: "bras", plural: "bras"},
+	&Rule{singular: "cas", plural: "cas"},
+	&Rule{singular: "choix", plural: "choix"},
+	&Rule{singular: "colis", plural: "colis"},
+	&Rule{singular: "concours", plural: "concours"},
+	&Rule{singular: "corps", plural: "corps"},
+	&Rule{singular: "cours", plural: "cours"},
+	&Rule{singular: "croix", plural: "croix"},
+	&Rule{singular: "discours", plural: "discours"},
+	&Rule{singular: "dos", plural: "dos"},
+	&Rule{singular: "époux", plural: "époux"},
+	&Rule{singular: "excès", plural: "excès"},
+	&Rule{singular: "fils", plural: "fils"},
+	&Rule{singular: "fois", plural: "fois"},
+	&Rule{singular: "gaz", plural: "gaz"},
+	&Rule{singular: "héros", plural: "héros"},
+	&Rule{singular: "jus", plural: "jus"},
+	&Rule{singular: "mois", plural: "mois"},
+	&Rule{singular: "nez", plural: "nez"},
+	&Rule{singular: "os", plural: "os"},
+	&Rule{singular: "palais", plural: "palais"},
+	&Rule{singular: "pays", plural: "pays"},
+	&Rule{singular: "poids", plural: "poids"},
+	&Rule{singular: "pouls", plural: "pouls"},
+	&Rule{singular: "prix", plural: "prix"},
+	&Rule{singular: "procès", plural: "procès"},
+	&Rule{singular: "processus", plural: "processus"},
+	&Rule{singular: "progrès", plural: "progrès"},
+	&Rule{singular: "puits", plural: "puits"},
+	&Rule{singular: "radis", plural: "radis"},
+	&Rule{singular: "remords", plural: "remords"},
+	&Rule{singular: "repas", plural: "repas"},
+	&Rule{singular: "riz", plural: "riz"},
+	&Rule{singular: "souris", plural: "souris"},
+	&Rule{singular: "succès", plural: "succès"},
+	&Rule{singular: "tapis", plural: "tapis"},
+	&Rule{singular: "temps", plural: "temps"},
+	&Rule{singular: "virus", plural: "virus"},
+	&Rule{singular: "voix", plural: "voix"},
+}
@@ -0,0 +1,85 @@
+package inflection_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tjimsk/inflection"
+)
+
+var frenchInflections = []testData{
+	testData{"chat", "chats"},
+	testData{"maison", "maisons"},
+	testData{"voiture", "voitures"},
+	testData{"clou", "clous"},
+	testData{"trou", "trous"},
+	testData{"détail", "détails"},
+	testData{"éventail", "éventails"},
+	testData{"cheval", "chevaux"},
+	testData{"journal", "journaux"},
+	testData{"animal", "animaux"},
+	testData{"hôpital", "hôpitaux"},
+	testData{"général", "généraux"},
+	testData{"bal", "bals"},
+	testData{"carnaval", "carnavals"},
+	testData{"chacal", "chacals"},
+	testData{"festival", "festivals"},
+	testData{"récital", "récitals"},
+	testData{"régal", "régals"},
+	testData{"bateau", "bateaux"},
+	testData{"gâteau", "gâteaux"},
+	testData{"oiseau", "oiseaux"},
+	testData{"tuyau", "tuyaux"},
+	testData{"noyau", "noyaux"},
+	testData{"landau", "landaus"},
+	testData{"jeu", "jeux"},
+	testData{"feu", "feux"},
+	testData{"cheveu", "cheveux"},
+	testData{"neveu", "neveux"},
+	testData{"lieu", "lieux"},
+	testData{"pneu", "pneus"},
+	testData{"bleu", "bleus"},
+	testData{"bijou", "bijoux"},
+	testData{"caillou", "cailloux"},
+	testData{"chou", "choux"},
+	testData{"genou", "genoux"},
+	testData{"hibou", "hiboux"},
+	testData{"joujou", "joujoux"},
+	testData{"pou", "poux"},
+	testData{"travail", "travaux"},
+	testData{"vitrail", "vitraux"},
+	testData{"corail", "coraux"},
+	testData{"émail", "émaux"},
+	testData{"œil", "yeux"},
+	testData{"ciel", "cieux"},
+	testData{"monsieur", "messieurs"},
+	testData{"madame", "mesdames"},
+	testData{"bras", "bras"},
+	testData{"prix", "prix"},
+	testData{"nez", "nez"},
+	testData{"temps", "temps"},
+	testData{"souris", "souris"},
+	testData{"voix", "voix"},
+	testData{"gaz", "gaz"},
+	testData{"époux", "époux"},
+	testData{"Cheval", "Chevaux"},
+	testData{"BATEAU", "BATEAUX"},
+	testData{"Œil", "Yeux"},
+	testData{"nom_journal", "nom_journaux"},
+}
+
+func TestFrenchInflections(t *testing.T) {
+	fr, err := inflection.NewLanguage("fr-CA")
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	for _, td := range frenchInflections {
+		assert.Equal(t, td.plural, fr.Pluralize(td.singular), "wrong plural for %v", td.singular)
+		assert.Equal(t, td.singular, fr.Singularize(td.plural), "wrong singular for %v", td.plural)
+	}
+
+	for _, word := range []string{"chevaux", "bijoux", "prix"} {
+		assert.True(t, fr.IsPlural(word), "%v should be plural", word)
+	}
+}
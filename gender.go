@@ -0,0 +1,30 @@
+package inflection
+
+import "strconv"
+
+// Gender is the grammatical gender of a noun. Languages such as German pick
+// plural endings by gender, so rules may be restricted to one and a gender
+// hint passed to PluralizeGender and SingularizeGender.
+type Gender int
+
+const (
+	NoGender Gender = iota
+	Masculine
+	Feminine
+	Neuter
+)
+
+func (g Gender) String() string {
+	switch g {
+	case NoGender:
+		return "none"
+	case Masculine:
+		return "masculine"
+	case Feminine:
+		return "feminine"
+	case Neuter:
+		return "neuter"
+	}
+
+	return "Gender(" + strconv.Itoa(int(g)) + ")"
+}
@@ -0,0 +1,126 @@
+package inflection
+
+// German plurals depend on gender as much as on the ending of a noun. Without
+// a gender hint, nouns ending in -e are taken to be singular, since most of
+// them are feminine (Blume, Blumen); the plurals in -e of masculine and
+// neuter nouns (Tage, Jahre) are only singularized with a Masculine or Neuter
+// hint, as are those ending in -en that do not change (Wagen). Plurals with an
+// Umlaut are irregular and listed as such.
+var germanPlurals = []*Rule{
+	&Rule{singular: `(\pL)$`, plural: "${1}e"},
+	&Rule{singular: `(\pL)$`, plural: "${1}en", gender: Feminine},
+	&Rule{singular: "e$", plural: "en"},
+	&Rule{singular: "(el|er|en|lein)$", plural: "${1}"},
+	&Rule{singular: "(el|er)$", plural: "${1}n", gender: Feminine},
+	&Rule{singular: "(ung|heit|keit|schaft|ion|tät|ur|ik|enz|anz|ei)$", plural: "${1}en"},
+	&Rule{singular: "(er)in$", plural: "${1}innen"},
+	&Rule{singular: "in$", plural: "innen", gender: Feminine},
+	&Rule{singular: "(nis)$", plural: "${1}se"},
+	&Rule{singular: "(ism)us$", plural: "${1}en"},
+	&Rule{singular: "tum$", plural: "tümer"},
+	&Rule{singular: "([^aeiouäöü][aiouy])$", plural: "${1}s"},
+}
+
+var germanSingulars = []*Rule{
+	&Rule{plural: "n$", singular: ""},
+	&Rule{plural: "(el|er|chen|lein)$", singular: "${1}"},
+	&Rule{plural: "e$", singular: "", gender: Masculine},
+	&Rule{plural: "e$", singular: "", gender: Neuter},
+	&Rule{plural: "en$", singular: "en", gender: Masculine},
+	&Rule{plural: "en$", singular: "en", gender: Neuter},
+	&Rule{plural: "(ung|heit|keit|schaft|ion|tät|ur|ik|enz|anz|ei)en$", singular: "${1}"},
+	&Rule{plural: "innen$", singular: "in"},
+	&Rule{plural: "(nis)se$", singular: "${1}"},
+	&Rule{plural: "(ism)en$", singular: "${1}us"},
+	&Rule{plural: "tümer$", singular: "tum"},
+	&Rule{plural: "([^aeiouäöü][aiouy])s$", singular: "${1}"},
+	&Rule{plural: "(nis|ismus)$", singular: "${1}"},
+}
+
+// Irregulars match the end of a noun, so "zug" also covers "Anzug". A later
+// entry overrides an earlier one it is a suffix of, which is why the
+// feminine nouns in -en come last: "antwort" must win over "wort".
+var germanIrregulars = []*Rule{
+	&Rule{singular: "apfel", plural: "äpfel"},
+	&Rule{singular: "arzt", plural: "ärzte"},
+	&Rule{singular: "band", plural: "bände", gender: Masculine},
+	&Rule{singular: "band", plural: "bands", gender: Feminine},
+	&Rule{singular: "band", plural: "bänder", gender: Neuter},
+	&Rule{singular: "baum", plural: "bäume"},
+	&Rule{singular: "bild", plural: "bilder"},
+	&Rule{singular: "blatt", plural: "blätter"},
+	&Rule{singular: "boden", plural: "böden"},
+	&Rule{singular: "bruder", plural: "brüder"},
+	&Rule{singular: "buch", plural: "bücher"},
+	&Rule{singular: "bus", plural: "busse"},
+	&Rule{singular: "dorf", plural: "dörfer"},
+	&Rule{singular: "feld", plural: "felder"},
+	&Rule{singular: "fuß", plural: "füße"},
+	&Rule{singular: "garten", plural: "gärten"},
+	&Rule{singular: "gast", plural: "gäste"},
+	&Rule{singular: "geist", plural: "geister"},
+	&Rule{singular: "glas", plural: "gläser"},
+	&Rule{singular: "gott", plural: "götter"},
+	&Rule{singular: "hand", plural: "hände"},
+	&Rule{singular: "haus", plural: "häuser"},
+	&Rule{singular: "kind", plural: "kinder"},
+	&Rule{singular: "kleid", plural: "kleider"},
+	&Rule{singular: "kopf", plural: "köpfe"},
+	&Rule{singular: "kuh", plural: "kühe"},
+	&Rule{singular: "land", plural: "länder"},
+	&Rule{singular: "lied", plural: "lieder"},
+	&Rule{singular: "mann", plural: "männer"},
+	&Rule{singular: "mantel", plural: "mäntel"},
+	&Rule{singular: "maus", plural: "mäuse"},
+	&Rule{singular: "mutter", plural: "mütter"},
+	&Rule{singular: "nacht", plural: "nächte"},
+	&Rule{singular: "ofen", plural: "öfen"},
+	&Rule{singular: "platz", plural: "plätze"},
+	&Rule{singular: "sohn", plural: "söhne"},
+	&Rule{singular: "stadt", plural: "städte"},
+	&Rule{singular: "stuhl", plural: "stühle"},
+	&Rule{singular: "tochter", plural: "töchter"},
+	&Rule{singular: "vater", plural: "väter"},
+	&Rule{singular: "vogel", plural: "vögel"},
+	&Rule{singular: "volk", plural: "völker"},
+	&Rule{singular: "wald", plural: "wälder"},
+	&Rule{singular: "wand", plural: "wände"},
+	&Rule{singular: "wort", plural: "wörter"},
+	&Rule{singular: "wurst", plural: "würste"},
+	&Rule{singular: "zug", plural: "züge"},
+	&Rule{singular: "auge", plural: "augen"},
+	&Rule{singular: "bau", plural: "bauten"},
+	&Rule{singular: "ende", plural: "enden"},
+	&Rule{singular: "firma", plural: "firmen"},
+	&Rule{singular: "herr", plural: "herren"},
+	&Rule{singular: "junge", plural: "jungen"},
+	&Rule{singular: "konto", plural: "konten"},
+	&Rule{singular: "mensch", plural: "menschen"},
+	&Rule{singular: "museum", plural: "museen"},
+	&Rule{singular: "name", plural: "namen"},
+	&Rule{singular: "student", plural: "studenten"},
+	&Rule{singular: "studium", plural: "studien"},
+	&Rule{singular: "thema", plural: "themen"},
+	&Rule{singular: "virus", plural: "viren"},
+	&Rule{singular: "zentrum", plural: "zentren"},
+	&Rule{singular: "antwort", plural: "antworten"},
+	&Rule{singular: "arbeit", plural: "arbeiten"},
+	&Rule{singular: "frau", plural: "frauen"},
+	&Rule{singular: "tür", plural: "türen"},
+	&Rule{singular: "uhr", plural: "uhren"},
+	&Rule{singular: "welt", plural: "welten"},
+	&Rule{singular: "zahl", plural: "zahlen"},
+	&Rule{singular: "zeit", plural: "zeiten"},
+}
+
+var germanUncountables = []*Rule{
+	&Rule{singular: "eltern", plural: "eltern"},
+	&Rule{singular: "ferien", plural: "ferien"},
+	&Rule{singular: "fleisch", plural: "fleisch"},
+	&Rule{singular: "geld", plural: "geld"},
+	&Rule{singular: "gemüse", plural: "gemüse"},
+	&Rule{singular: "käse", plural: "käse"},
+	&Rule{singular: "leute", plural: "leute"},
+	&Rule{singular: "milch", plural: "milch"},
+	&Rule{singular: "obst", plural: "obst"},
+}
@@ -0,0 +1,116 @@
+package inflection_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tjimsk/inflection"
+)
+
+var germanInflections = []testData{
+	testData{"Blume", "Blumen"},
+	testData{"Straße", "Straßen"},
+	testData{"Familie", "Familien"},
+	testData{"Idee", "Ideen"},
+	testData{"Lehrer", "Lehrer"},
+	testData{"Löffel", "Löffel"},
+	testData{"Mädchen", "Mädchen"},
+	testData{"Fräulein", "Fräulein"},
+	testData{"Zeitung", "Zeitungen"},
+	testData{"Freiheit", "Freiheiten"},
+	testData{"Möglichkeit", "Möglichkeiten"},
+	testData{"Mannschaft", "Mannschaften"},
+	testData{"Nation", "Nationen"},
+	testData{"Universität", "Universitäten"},
+	testData{"Kultur", "Kulturen"},
+	testData{"Bäckerei", "Bäckereien"},
+	testData{"Lehrerin", "Lehrerinnen"},
+	testData{"Ergebnis", "Ergebnisse"},
+	testData{"Organismus", "Organismen"},
+	testData{"Irrtum", "Irrtümer"},
+	testData{"Auto", "Autos"},
+	testData{"Kamera", "Kameras"},
+	testData{"Hobby", "Hobbys"},
+	testData{"Apfel", "Äpfel"},
+	testData{"Vater", "Väter"},
+	testData{"Garten", "Gärten"},
+	testData{"Hand", "Hände"},
+	testData{"Stadt", "Städte"},
+	testData{"Maus", "Mäuse"},
+	testData{"Anzug", "Anzüge"},
+	testData{"Kind", "Kinder"},
+	testData{"Buch", "Bücher"},
+	testData{"Haus", "Häuser"},
+	testData{"Rathaus", "Rathäuser"},
+	testData{"Mann", "Männer"},
+	testData{"Wort", "Wörter"},
+	testData{"Antwort", "Antworten"},
+	testData{"Frau", "Frauen"},
+	testData{"Junge", "Jungen"},
+	testData{"Name", "Namen"},
+	testData{"Museum", "Museen"},
+	testData{"Thema", "Themen"},
+	testData{"Bus", "Busse"},
+	testData{"Eltern", "Eltern"},
+	testData{"Obst", "Obst"},
+	testData{"APFEL", "ÄPFEL"},
+	testData{"kunden_zeitung", "kunden_zeitungen"},
+}
+
+func TestGermanInflections(t *testing.T) {
+	de, err := inflection.NewLanguage("de-AT")
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	for _, td := range germanInflections {
+		assert.Equal(t, td.plural, de.Pluralize(td.singular), "wrong plural for %v", td.singular)
+		assert.Equal(t, td.singular, de.Singularize(td.plural), "wrong singular for %v", td.plural)
+	}
+}
+
+func TestGermanGenderHints(t *testing.T) {
+	de, err := inflection.NewLanguage("de")
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	data := []struct {
+		gender   inflection.Gender
+		singular string
+		plural   string
+	}{
+		{inflection.Masculine, "Tag", "Tage"},
+		{inflection.Masculine, "Hund", "Hunde"},
+		{inflection.Masculine, "Wagen", "Wagen"},
+		{inflection.Masculine, "Leiter", "Leiter"},
+		{inflection.Masculine, "Band", "Bände"},
+		{inflection.Neuter, "Jahr", "Jahre"},
+		{inflection.Neuter, "Zeichen", "Zeichen"},
+		{inflection.Neuter, "Band", "Bänder"},
+		{inflection.Feminine, "Schwester", "Schwestern"},
+		{inflection.Feminine, "Regel", "Regeln"},
+		{inflection.Feminine, "Leiter", "Leitern"},
+		{inflection.Feminine, "Freundin", "Freundinnen"},
+		{inflection.Feminine, "Band", "Bands"},
+		{inflection.Feminine, "Blume", "Blumen"},
+		{inflection.Feminine, "Hand", "Hände"},
+	}
+
+	for _, td := range data {
+		assert.Equal(t, td.plural, de.PluralizeGender(td.singular, td.gender), "wrong %v plural for %v", td.gender, td.singular)
+		assert.Equal(t, td.singular, de.SingularizeGender(td.plural, td.gender), "wrong %v singular for %v", td.gender, td.plural)
+	}
+
+	assert.Equal(t, "Schwester", de.Pluralize("Schwester"))
+	assert.Equal(t, "Tage", de.Singularize("Tage"))
+	assert.Equal(t, "Frauen", de.PluralizeGender("Frau", inflection.Feminine))
+	assert.Equal(t, "Türen", de.PluralizeGender("Tür", inflection.Masculine))
+
+	de.EnableCache(16)
+	assert.Equal(t, "Leitern", de.PluralizeGender("Leiter", inflection.Feminine))
+	assert.Equal(t, "Leiter", de.PluralizeGender("Leiter", inflection.Masculine))
+
+	assert.Equal(t, "feminine", inflection.Feminine.String())
+	assert.Equal(t, "people", inflection.PluralizeGender("person", inflection.Masculine))
+}
@@ -4,11 +4,11 @@ import (
 	"fmt"
 	"regexp"
 	"strings"
-)
+	"sync"
+	"unicode"
+	"unicode/utf8"
 
-var (
-	pluralize   []*Rule
-	singularize []*Rule
+	"golang.org/x/text/language"
 )
 
 type Rule struct {
@@ -16,399 +16,205 @@ type Rule struct {
 	plural     string
 	singularRe *regexp.Regexp
 	pluralRe   *regexp.Regexp
+	gender     Gender
+	table      Table
+	source     *Rule
 }
 
-func (r *Rule) compile() (err error) {
-	r.singularRe, err = regexp.Compile(r.singular)
-	if err != nil {
-		return err
+func NewRule(singular, plural string) *Rule {
+	return &Rule{singular: singular, plural: plural}
+}
+
+// NewGenderRule returns a rule that only applies to nouns inflected with the
+// given gender hint.
+func NewGenderRule(singular, plural string, gender Gender) *Rule {
+	return &Rule{singular: singular, plural: plural, gender: gender}
+}
+
+func (r *Rule) Singular() string {
+	return r.singular
+}
+
+func (r *Rule) Plural() string {
+	return r.plural
+}
+
+func (r *Rule) Gender() Gender {
+	return r.gender
+}
+
+// appliesTo reports whether the rule may be used for a noun of the given
+// gender: rules without a gender apply to every noun.
+func (r *Rule) appliesTo(gender Gender) bool {
+	return r.gender == NoGender || r.gender == gender
+}
+
+// compile compiles the pattern that a rule matches: the singular to
+// pluralize, or the plural to singularize. The other side is a replacement,
+// which need not be a valid pattern.
+func (r *Rule) compile(pluralize bool) (err error) {
+	if pluralize {
+		r.singularRe, err = regexp.Compile(r.singular)
+	} else {
+		r.pluralRe, err = regexp.Compile(r.plural)
 	}
 
-	r.pluralRe, err = regexp.Compile(r.plural)
+	return err
+}
+
+const wordDelimiter = `(^|[^\pL\pN])`
+
+type Table string
+
+const (
+	PluralTable      Table = "plural"
+	SingularTable    Table = "singular"
+	IrregularTable   Table = "irregular"
+	UncountableTable Table = "uncountable"
+)
+
+type Rules struct {
+	Plurals      []*Rule
+	Singulars    []*Rule
+	Irregulars   []*Rule
+	Uncountables []*Rule
+}
+
+type RuleError struct {
+	Table Table
+	Rule  *Rule
+	Err   error
+}
+
+func (e *RuleError) Error() string {
+	return fmt.Sprintf("inflection: invalid %v rule %q => %q: %v", e.Table, e.Rule.singular, e.Rule.plural, e.Err)
+}
+
+func (e *RuleError) Unwrap() error {
+	return e.Err
+}
+
+var smallWords = []string{
+	"a", "an", "and", "as", "at", "but", "by", "en", "for", "if", "in", "nor",
+	"of", "on", "or", "per", "the", "to", "via", "vs",
+}
+
+type Inflector struct {
+	plurals      []*Rule
+	singulars    []*Rule
+	irregulars   []*Rule
+	uncountables []*Rule
+
+	pluralize   []*Rule
+	singularize []*Rule
+
+	pluralIndex   *suffixIndex
+	singularIndex *suffixIndex
+
+	acronyms   map[string]string
+	smallWords map[string]bool
+
+	mu    sync.RWMutex
+	cache *lruCache
+}
+
+var defaultInflector = For(language.English)
+
+func New() *Inflector {
+	in, err := NewWithRules(Rules{
+		Plurals:      englishPlurals,
+		Singulars:    englishSingulars,
+		Irregulars:   englishIrregulars,
+		Uncountables: englishUncountables,
+	})
 	if err != nil {
-		return err
+		panic(err)
 	}
 
-	return nil
+	return in
 }
 
-var plurals = []*Rule{
-	&Rule{singular: "([a-z])$", plural: "${1}s"},
-	&Rule{singular: "s$", plural: "s"},
-	&Rule{singular: "^(ax|test)is$", plural: "${1}es"},
-	&Rule{singular: "(octop|vir)us$", plural: "${1}i"},
-	&Rule{singular: "(octop|vir)i$", plural: "${1}i"},
-	&Rule{singular: "(alias|status)$", plural: "${1}es"},
-	&Rule{singular: "(bu)s$", plural: "${1}ses"},
-	&Rule{singular: "(buffal|tomat)o$", plural: "${1}oes"},
-	&Rule{singular: "([ti])um$", plural: "${1}a"},
-	&Rule{singular: "([ti])a$", plural: "${1}a"},
-	&Rule{singular: "sis$", plural: "ses"},
-	&Rule{singular: "(?:([^f])fe|([lr])f)$", plural: "${1}${2}ves"},
-	&Rule{singular: "(hive)$", plural: "${1}s"},
-	&Rule{singular: "([^aeiouy]|qu)y$", plural: "${1}ies"},
-	&Rule{singular: "(x|ch|ss|sh)$", plural: "${1}es"},
-	&Rule{singular: "(matr|vert|ind)(?:ix|ex)$", plural: "${1}ices"},
-	&Rule{singular: "^(m|l)ouse$", plural: "${1}ice"},
-	&Rule{singular: "^(m|l)ice$", plural: "${1}ice"},
-	&Rule{singular: "^(ox)$", plural: "${1}en"},
-	&Rule{singular: "^(oxen)$", plural: "${1}"},
-	&Rule{singular: "(quiz)$", plural: "${1}zes"},
-}
-
-var singulars = []*Rule{
-	&Rule{plural: "s$", singular: ""},
-	&Rule{plural: "(ss)$", singular: "${1}"},
-	&Rule{plural: "(n)ews$", singular: "${1}ews"},
-	&Rule{plural: "([ti])a$", singular: "${1}um"},
-	&Rule{plural: "((a)naly|(b)a|(d)iagno|(p)arenthe|(p)rogno|(s)ynop|(t)he)(sis|ses)$", singular: "${1}sis"},
-	&Rule{plural: "(^analy)(sis|ses)$", singular: "${1}sis"},
-	&Rule{plural: "([^f])ves$", singular: "${1}fe"},
-	&Rule{plural: "(hive)s$", singular: "${1}"},
-	&Rule{plural: "(tive)s$", singular: "${1}"},
-	&Rule{plural: "([lr])ves$", singular: "${1}f"},
-	&Rule{plural: "([^aeiouy]|qu)ies$", singular: "${1}y"},
-	&Rule{plural: "(s)eries$", singular: "${1}eries"},
-	&Rule{plural: "(m)ovies$", singular: "${1}ovie"},
-	&Rule{plural: "(c)ookies$", singular: "${1}ookie"},
-	&Rule{plural: "(x|ch|ss|sh)es$", singular: "${1}"},
-	&Rule{plural: "^(m|l)ice$", singular: "${1}ouse"},
-	&Rule{plural: "(bus)(es)?$", singular: "${1}"},
-	&Rule{plural: "(o)es$", singular: "${1}"},
-	&Rule{plural: "(shoe)s$", singular: "${1}"},
-	&Rule{plural: "(cris|test)(is|es)$", singular: "${1}is"},
-	&Rule{plural: "^(a)x[ie]s$", singular: "${1}xis"},
-	&Rule{plural: "(octop|vir)(us|i)$", singular: "${1}us"},
-	&Rule{plural: "(alias|status)(es)?$", singular: "${1}"},
-	&Rule{plural: "^(ox)en", singular: "${1}"},
-	&Rule{plural: "(vert|ind)ices$", singular: "${1}ex"},
-	&Rule{plural: "(matr)ices$", singular: "${1}ix"},
-	&Rule{plural: "(quiz)zes$", singular: "${1}"},
-	&Rule{plural: "(database)s$", singular: "${1}"},
-}
-
-var irregulars = []*Rule{
-	&Rule{singular: "addendum", plural: "addenda"},
-	&Rule{singular: "alga", plural: "algae"},
-	&Rule{singular: "alumna", plural: "alumnae"},
-	&Rule{singular: "alumnus", plural: "alumni"},
-	&Rule{singular: "analysis", plural: "analyses"},
-	&Rule{singular: "antenna", plural: "antennae"},
-	&Rule{singular: "apparatus", plural: "apparatuses"},
-	&Rule{singular: "appendix", plural: "appendices"},
-	// &Rule{singular: "axis", plural: "axes"},
-	&Rule{singular: "bacillus", plural: "bacilli"},
-	&Rule{singular: "bacterium", plural: "bacteria"},
-	&Rule{singular: "basis", plural: "bases"},
-	&Rule{singular: "beau", plural: "beaux"},
-	&Rule{singular: "bison", plural: "bison"},
-	&Rule{singular: "buffalo", plural: "buffaloes"},
-	&Rule{singular: "bureau", plural: "bureaus"},
-	&Rule{singular: "bus", plural: "buses"},
-	&Rule{singular: "cactus", plural: "cacti"},
-	// &Rule{singular: "calf", plural: "calves"},
-	&Rule{singular: "child", plural: "children"},
-	&Rule{singular: "corps", plural: "corps"},
-	&Rule{singular: "corpus", plural: "corpora"},
-	// &Rule{singular: "crisis", plural: "crises"},
-	&Rule{singular: "criterion", plural: "criteria"},
-	&Rule{singular: "curriculum", plural: "curricula"},
-	&Rule{singular: "datum", plural: "data"},
-	&Rule{singular: "deer", plural: "deer"},
-	&Rule{singular: "die", plural: "dice"},
-	// &Rule{singular: "dwarf", plural: "dwarves"},
-	&Rule{singular: "diagnosis", plural: "diagnoses"},
-	&Rule{singular: "echo", plural: "echoes"},
-	&Rule{singular: "elf", plural: "elves"},
-	&Rule{singular: "ellipsis", plural: "ellipses"},
-	&Rule{singular: "embargo", plural: "embargoes"},
-	&Rule{singular: "emphasis", plural: "emphases"},
-	&Rule{singular: "erratum", plural: "errata"},
-	&Rule{singular: "fireman", plural: "firemen"},
-	&Rule{singular: "fish", plural: "fish"},
-	&Rule{singular: "focus", plural: "focuses"},
-	&Rule{singular: "foot", plural: "feet"},
-	&Rule{singular: "formula", plural: "formulas"},
-	&Rule{singular: "fungus", plural: "fungi"},
-	&Rule{singular: "genus", plural: "genera"},
-	&Rule{singular: "goose", plural: "geese"},
-	// &Rule{singular: "half", plural: "halves"},
-	&Rule{singular: "hero", plural: "heroes"},
-	&Rule{singular: "hippopotamus", plural: "hippopotami"},
-	&Rule{singular: "hoof", plural: "hooves"},
-	&Rule{singular: "hypothesis", plural: "hypotheses"},
-	&Rule{singular: "index", plural: "indices"},
-	&Rule{singular: "knife", plural: "knives"},
-	&Rule{singular: "leaf", plural: "leaves"},
-	&Rule{singular: "life", plural: "lives"},
-	&Rule{singular: "loaf", plural: "loaves"},
-	&Rule{singular: "louse", plural: "lice"},
-	&Rule{singular: "man", plural: "men"},
-	&Rule{singular: "matrix", plural: "matrices"},
-	&Rule{singular: "means", plural: "means"},
-	&Rule{singular: "medium", plural: "media"},
-	&Rule{singular: "memorandum", plural: "memoranda"},
-	&Rule{singular: "millennium", plural: "milennia"},
-	&Rule{singular: "mombie", plural: "mombies"},
-	&Rule{singular: "moose", plural: "moose"},
-	&Rule{singular: "mosquito", plural: "mosquitoes"},
-	&Rule{singular: "mouse", plural: "mice"},
-	&Rule{singular: "move", plural: "moves"},
-	&Rule{singular: "nebula", plural: "nebulaenebulas"},
-	&Rule{singular: "neurosis", plural: "neuroses"},
-	&Rule{singular: "nucleus", plural: "nuclei"},
-	&Rule{singular: "oasis", plural: "oases"},
-	&Rule{singular: "octopus", plural: "octopi"},
-	&Rule{singular: "ovum", plural: "ova"},
-	&Rule{singular: "ox", plural: "oxen"},
-	&Rule{singular: "paralysis", plural: "paralyses"},
-	&Rule{singular: "parenthesis", plural: "parentheses"},
-	&Rule{singular: "person", plural: "people"},
-	&Rule{singular: "phenomenon", plural: "phenomena"},
-	&Rule{singular: "potato", plural: "potatoes"},
-	&Rule{singular: "radius", plural: "radii"},
-	&Rule{singular: "scarf", plural: "scarves"},
-	&Rule{singular: "sex", plural: "sexes"},
-	&Rule{singular: "self", plural: "selves"},
-	&Rule{singular: "series", plural: "series"},
-	&Rule{singular: "sheep", plural: "sheep"},
-	&Rule{singular: "scissors", plural: "scissors"},
-	&Rule{singular: "species", plural: "species"},
-	&Rule{singular: "stimulus", plural: "stimuli"},
-	&Rule{singular: "stratum", plural: "strata"},
-	&Rule{singular: "syllabus", plural: "syllabi"},
-	&Rule{singular: "symposium", plural: "symposia"},
-	&Rule{singular: "synthesis", plural: "syntheses"},
-	&Rule{singular: "synopsis", plural: "synopses"},
-	&Rule{singular: "tableau", plural: "tableaux"},
-	&Rule{singular: "that", plural: "those"},
-	&Rule{singular: "thesis", plural: "theses"},
-	&Rule{singular: "thief", plural: "thieves"},
-	&Rule{singular: "this", plural: "these"},
-	&Rule{singular: "tomato", plural: "tomatoes"},
-	&Rule{singular: "tooth", plural: "teeth"},
-	&Rule{singular: "torpedo", plural: "torpedoes"},
-	&Rule{singular: "vertebra", plural: "vertebrae"},
-	&Rule{singular: "veto", plural: "vetoes"},
-	&Rule{singular: "vita", plural: "vitae"},
-	&Rule{singular: "watch", plural: "watches"},
-	&Rule{singular: "wife", plural: "wives"},
-	&Rule{singular: "wolf", plural: "wolves"},
-	&Rule{singular: "woman", plural: "women"},
-	&Rule{singular: "zero", plural: "zeroes"},
-}
-
-var uncountables = []*Rule{
-	&Rule{singular: "accommodation", plural: "accommodation"},
-	&Rule{singular: "advertising", plural: "advertising"},
-	&Rule{singular: "air", plural: "air"},
-	&Rule{singular: "aid", plural: "aid"},
-	&Rule{singular: "advice", plural: "advice"},
-	&Rule{singular: "anger", plural: "anger"},
-	&Rule{singular: "art", plural: "art"},
-	&Rule{singular: "assistance", plural: "assistance"},
-	&Rule{singular: "bread", plural: "bread"},
-	&Rule{singular: "business", plural: "business"},
-	&Rule{singular: "butter", plural: "butter"},
-	&Rule{singular: "calm", plural: "calm"},
-	&Rule{singular: "cash", plural: "cash"},
-	&Rule{singular: "chaos", plural: "chaos"},
-	&Rule{singular: "cheese", plural: "cheese"},
-	&Rule{singular: "childhood", plural: "childhood"},
-	&Rule{singular: "clothing", plural: "clothing"},
-	&Rule{singular: "coffee", plural: "coffee"},
-	&Rule{singular: "content", plural: "content"},
-	&Rule{singular: "corruption", plural: "corruption"},
-	&Rule{singular: "courage", plural: "courage"},
-	&Rule{singular: "currency", plural: "currency"},
-	&Rule{singular: "damage", plural: "damage"},
-	&Rule{singular: "danger", plural: "danger"},
-	&Rule{singular: "darkness", plural: "darkness"},
-	&Rule{singular: "determination", plural: "determination"},
-	&Rule{singular: "economics", plural: "economics"},
-	&Rule{singular: "education", plural: "education"},
-	&Rule{singular: "electricity", plural: "electricity"},
-	&Rule{singular: "employment", plural: "employment"},
-	&Rule{singular: "energy", plural: "energy"},
-	&Rule{singular: "entertainment", plural: "entertainment"},
-	&Rule{singular: "enthusiasm", plural: "enthusiasm"},
-	&Rule{singular: "equipment", plural: "equipment"},
-	&Rule{singular: "evidence", plural: "evidence"},
-	&Rule{singular: "failure", plural: "failure"},
-	&Rule{singular: "fame", plural: "fame"},
-	&Rule{singular: "fire", plural: "fire"},
-	&Rule{singular: "flour", plural: "flour"},
-	&Rule{singular: "food", plural: "food"},
-	&Rule{singular: "freedom", plural: "freedom"},
-	&Rule{singular: "friendship", plural: "friendship"},
-	&Rule{singular: "fuel", plural: "fuel"},
-	&Rule{singular: "furniture", plural: "furniture"},
-	&Rule{singular: "fun", plural: "fun"},
-	&Rule{singular: "genetics", plural: "genetics"},
-	&Rule{singular: "gold", plural: "gold"},
-	&Rule{singular: "grammar", plural: "grammar"},
-	&Rule{singular: "guilt", plural: "guilt"},
-	&Rule{singular: "hair", plural: "hair"},
-	&Rule{singular: "happiness", plural: "happiness"},
-	&Rule{singular: "harm", plural: "harm"},
-	&Rule{singular: "health", plural: "health"},
-	&Rule{singular: "heat", plural: "heat"},
-	&Rule{singular: "help", plural: "help"},
-	&Rule{singular: "homework", plural: "homework"},
-	&Rule{singular: "honesty", plural: "honesty"},
-	&Rule{singular: "hospitality", plural: "hospitality"},
-	&Rule{singular: "housework", plural: "housework"},
-	&Rule{singular: "humour", plural: "humour"},
-	&Rule{singular: "imagination", plural: "imagination"},
-	&Rule{singular: "importance", plural: "importance"},
-	&Rule{singular: "information", plural: "information"},
-	&Rule{singular: "innocence", plural: "innocence"},
-	&Rule{singular: "intelligence", plural: "intelligence"},
-	&Rule{singular: "jealousy", plural: "jealousy"},
-	&Rule{singular: "juice", plural: "juice"},
-	&Rule{singular: "justice", plural: "justice"},
-	&Rule{singular: "kindness", plural: "kindness"},
-	&Rule{singular: "knowledge", plural: "knowledge"},
-	&Rule{singular: "labour", plural: "labour"},
-	&Rule{singular: "lack", plural: "lack"},
-	&Rule{singular: "laughter", plural: "laughter"},
-	&Rule{singular: "leisure", plural: "leisure"},
-	&Rule{singular: "literature", plural: "literature"},
-	&Rule{singular: "litter", plural: "litter"},
-	&Rule{singular: "logic", plural: "logic"},
-	&Rule{singular: "love", plural: "love"},
-	&Rule{singular: "luck", plural: "luck"},
-	&Rule{singular: "magic", plural: "magic"},
-	&Rule{singular: "management", plural: "management"},
-	&Rule{singular: "metal", plural: "metal"},
-	&Rule{singular: "milk", plural: "milk"},
-	&Rule{singular: "money", plural: "money"},
-	&Rule{singular: "motherhood", plural: "motherhood"},
-	&Rule{singular: "motivation", plural: "motivation"},
-	&Rule{singular: "music", plural: "music"},
-	&Rule{singular: "nature", plural: "nature"},
-	&Rule{singular: "nutrition", plural: "nutrition"},
-	&Rule{singular: "obesity", plural: "obesity"},
-	&Rule{singular: "oil", plural: "oil"},
-	&Rule{singular: "old age", plural: "old age"},
-	&Rule{singular: "oxygen", plural: "oxygen"},
-	&Rule{singular: "paper", plural: "paper"},
-	&Rule{singular: "patience", plural: "patience"},
-	&Rule{singular: "permission", plural: "permission"},
-	&Rule{singular: "pollution", plural: "pollution"},
-	&Rule{singular: "poverty", plural: "poverty"},
-	&Rule{singular: "power", plural: "power"},
-	&Rule{singular: "pride", plural: "pride"},
-	&Rule{singular: "production", plural: "production"},
-	&Rule{singular: "progress", plural: "progress"},
-	&Rule{singular: "pronunciation", plural: "pronunciation"},
-	&Rule{singular: "publicity", plural: "publicity"},
-	&Rule{singular: "punctuation", plural: "punctuation"},
-	&Rule{singular: "quality", plural: "quality"},
-	&Rule{singular: "quantity", plural: "quantity"},
-	&Rule{singular: "racism", plural: "racism"},
-	&Rule{singular: "rain", plural: "rain"},
-	&Rule{singular: "relaxation", plural: "relaxation"},
-	&Rule{singular: "research", plural: "research"},
-	&Rule{singular: "respect", plural: "respect"},
-	&Rule{singular: "rice", plural: "rice"},
-	&Rule{singular: "room", plural: "room"},
-	&Rule{singular: "rubbish", plural: "rubbish"},
-	&Rule{singular: "safety", plural: "safety"},
-	&Rule{singular: "salt", plural: "salt"},
-	&Rule{singular: "sand", plural: "sand"},
-	&Rule{singular: "seafood", plural: "seafood"},
-	&Rule{singular: "shopping", plural: "shopping"},
-	&Rule{singular: "silence", plural: "silence"},
-	&Rule{singular: "smoke", plural: "smoke"},
-	&Rule{singular: "snow", plural: "snow"},
-	&Rule{singular: "software", plural: "software"},
-	&Rule{singular: "soup", plural: "soup"},
-	&Rule{singular: "speed", plural: "speed"},
-	&Rule{singular: "spelling", plural: "spelling"},
-	&Rule{singular: "stress", plural: "stress"},
-	&Rule{singular: "sugar", plural: "sugar"},
-	&Rule{singular: "sunshine", plural: "sunshine"},
-	&Rule{singular: "tea", plural: "tea"},
-	&Rule{singular: "tennis", plural: "tennis"},
-	&Rule{singular: "time", plural: "time"},
-	&Rule{singular: "tolerance", plural: "tolerance"},
-	&Rule{singular: "trade", plural: "trade"},
-	&Rule{singular: "traffic", plural: "traffic"},
-	&Rule{singular: "transportation", plural: "transportation"},
-	&Rule{singular: "travel", plural: "travel"},
-	&Rule{singular: "trust", plural: "trust"},
-	&Rule{singular: "understanding", plural: "understanding"},
-	&Rule{singular: "unemployment", plural: "unemployment"},
-	&Rule{singular: "usage", plural: "usage"},
-	&Rule{singular: "violence", plural: "violence"},
-	&Rule{singular: "vision", plural: "vision"},
-	&Rule{singular: "warmth", plural: "warmth"},
-	&Rule{singular: "water", plural: "water"},
-	&Rule{singular: "wealth", plural: "wealth"},
-	&Rule{singular: "weather", plural: "weather"},
-	&Rule{singular: "weight", plural: "weight"},
-	&Rule{singular: "welfare", plural: "welfare"},
-	&Rule{singular: "wheat", plural: "wheat"},
-	&Rule{singular: "width", plural: "width"},
-	&Rule{singular: "wildlife", plural: "wildlife"},
-	&Rule{singular: "wisdom", plural: "wisdom"},
-	&Rule{singular: "wood", plural: "wood"},
-	&Rule{singular: "work", plural: "work"},
-	&Rule{singular: "yoga", plural: "yoga"},
-	&Rule{singular: "youth", plural: "youth"},
-}
-
-func init() {
-	for _, r := range plurals {
-		pluralize = append(pluralize, caseInsensitivePluralRule(r))
-		pluralize = append(pluralize, upperCaseRule(r))
+func NewWithRules(rules Rules) (*Inflector, error) {
+	in := &Inflector{
+		plurals:      append([]*Rule(nil), rules.Plurals...),
+		singulars:    append([]*Rule(nil), rules.Singulars...),
+		irregulars:   append([]*Rule(nil), rules.Irregulars...),
+		uncountables: append([]*Rule(nil), rules.Uncountables...),
 	}
+	in.SetSmallWords(smallWords...)
 
-	for _, r := range singulars {
-		singularize = append(singularize, caseInsensitiveSingularRule(r))
-		singularize = append(singularize, upperCaseRule(r))
+	if err := in.compile(); err != nil {
+		return nil, err
 	}
 
-	for _, r := range uncountables {
-		pluralize = append(pluralize, caseInsensitivePluralRule(r))
-		pluralize = append(pluralize, upperCaseRule(r))
-		pluralize = append(pluralize, titleCaseRule(r))
+	return in, nil
+}
+
+func (in *Inflector) compile() error {
+	var pluralRules, singularRules []*Rule
 
-		singularize = append(singularize, caseInsensitiveSingularRule(r))
-		singularize = append(singularize, upperCaseRule(r))
-		singularize = append(singularize, titleCaseRule(r))
+	for _, r := range in.plurals {
+		pluralRules = append(pluralRules, sourcedRule(PluralTable, r, caseInsensitivePluralRule(r)))
 	}
 
-	for _, r := range irregulars {
-		pluralize = append(pluralize, caseInsensitivePluralRule(delimitedPluralRule(r)))
-		pluralize = append(pluralize, upperCaseRule(delimitedPluralRule(r)))
-		pluralize = append(pluralize, titleCaseRule(delimitedPluralRule(r)))
+	for _, r := range in.singulars {
+		singularRules = append(singularRules, sourcedRule(SingularTable, r, caseInsensitiveSingularRule(r)))
+	}
+
+	for _, r := range in.irregulars {
+		pluralRules = append(pluralRules, sourcedRule(IrregularTable, r, caseInsensitivePluralRule(delimitedPluralRule(r))))
+		singularRules = append(singularRules, sourcedRule(IrregularTable, r, caseInsensitiveSingularRule(delimitedSingularRule(r))))
+	}
 
-		singularize = append(singularize, caseInsensitiveSingularRule(delimitedSingularRule(r)))
-		singularize = append(singularize, upperCaseRule(delimitedSingularRule(r)))
-		singularize = append(singularize, titleCaseRule(delimitedSingularRule(r)))
+	// The last matching rule wins. Uncountables come after irregulars, so that
+	// an uncountable such as "wildlife" or "metadata" is not overridden by an
+	// irregular it ends with, such as "life" or "datum".
+	for _, r := range in.uncountables {
+		pluralRules = append(pluralRules, sourcedRule(UncountableTable, r, caseInsensitivePluralRule(wordPluralRule(r))))
+		singularRules = append(singularRules, sourcedRule(UncountableTable, r, caseInsensitiveSingularRule(wordSingularRule(r))))
 	}
 
-	for _, r := range pluralize {
-		if err := r.compile(); err != nil {
-			panic(err)
+	for _, r := range pluralRules {
+		if err := r.compile(true); err != nil {
+			return &RuleError{Table: r.table, Rule: r.source, Err: err}
 		}
 	}
 
-	for _, r := range singularize {
-		if err := r.compile(); err != nil {
-			panic(err)
+	for _, r := range singularRules {
+		if err := r.compile(false); err != nil {
+			return &RuleError{Table: r.table, Rule: r.source, Err: err}
 		}
 	}
+
+	in.pluralize, in.singularize = pluralRules, singularRules
+	in.pluralIndex = newSuffixIndex(singularPatterns(pluralRules))
+	in.singularIndex = newSuffixIndex(pluralPatterns(singularRules))
+
+	return nil
 }
 
-func upperCaseRule(r *Rule) *Rule {
-	return &Rule{singular: strings.ToUpper(r.singular), plural: strings.ToUpper(r.plural)}
+func singularPatterns(rules []*Rule) []*regexp.Regexp {
+	patterns := make([]*regexp.Regexp, len(rules))
+	for i, r := range rules {
+		patterns[i] = r.singularRe
+	}
+
+	return patterns
 }
 
-func titleCaseRule(r *Rule) *Rule {
-	return &Rule{singular: strings.Title(r.singular), plural: strings.Title(r.plural)}
+func pluralPatterns(rules []*Rule) []*regexp.Regexp {
+	patterns := make([]*regexp.Regexp, len(rules))
+	for i, r := range rules {
+		patterns[i] = r.pluralRe
+	}
+
+	return patterns
+}
+
+func sourcedRule(table Table, source *Rule, r *Rule) *Rule {
+	r.table, r.source, r.gender = table, source, source.gender
+
+	return r
 }
 
 func caseInsensitivePluralRule(r *Rule) *Rule {
@@ -427,26 +233,328 @@ func delimitedSingularRule(r *Rule) *Rule {
 	return &Rule{singular: r.singular, plural: fmt.Sprintf("%v$", r.plural)}
 }
 
-func Pluralize(noun string) (singular string) {
-	singular = noun
+// wordPluralRule and wordSingularRule anchor a rule to a whole word, either
+// the entire noun or the last segment of a delimited identifier such as
+// "old_news", so that "air" matches "hot_air" but not "chair".
+func wordPluralRule(r *Rule) *Rule {
+	return &Rule{singular: fmt.Sprintf("%v%v$", wordDelimiter, r.singular), plural: fmt.Sprintf("${1}%v", r.plural)}
+}
+
+func wordSingularRule(r *Rule) *Rule {
+	return &Rule{singular: fmt.Sprintf("${1}%v", r.singular), plural: fmt.Sprintf("%v%v$", wordDelimiter, r.plural)}
+}
+
+func Pluralize(noun string) string {
+	return defaultInflector.Pluralize(noun)
+}
+
+func Singularize(noun string) string {
+	return defaultInflector.Singularize(noun)
+}
+
+func PluralizeGender(noun string, gender Gender) string {
+	return defaultInflector.PluralizeGender(noun, gender)
+}
+
+func SingularizeGender(noun string, gender Gender) string {
+	return defaultInflector.SingularizeGender(noun, gender)
+}
+
+func IsPlural(noun string) bool {
+	return defaultInflector.IsPlural(noun)
+}
+
+func IsSingular(noun string) bool {
+	return defaultInflector.IsSingular(noun)
+}
+
+func EnableCache(capacity int) {
+	defaultInflector.EnableCache(capacity)
+}
+
+func CacheStats() CacheStatistics {
+	return defaultInflector.CacheStats()
+}
+
+func AddPlural(rule, replacement string) error {
+	return defaultInflector.AddPlural(rule, replacement)
+}
+
+func AddSingular(rule, replacement string) error {
+	return defaultInflector.AddSingular(rule, replacement)
+}
+
+func AddIrregular(singular, plural string) error {
+	return defaultInflector.AddIrregular(singular, plural)
+}
+
+func AddUncountable(words ...string) error {
+	return defaultInflector.AddUncountable(words...)
+}
+
+func (in *Inflector) Pluralize(noun string) string {
+	return in.PluralizeGender(noun, NoGender)
+}
+
+func (in *Inflector) Singularize(noun string) string {
+	return in.SingularizeGender(noun, NoGender)
+}
+
+// PluralizeGender is Pluralize with a hint of the grammatical gender of noun.
+// Rules restricted to another gender are skipped; NoGender only applies the
+// rules that have no gender.
+func (in *Inflector) PluralizeGender(noun string, gender Gender) string {
+	in.mu.RLock()
+	defer in.mu.RUnlock()
 
-	for _, r := range pluralize {
-		if r.singularRe.MatchString(noun) {
-			singular = r.singularRe.ReplaceAllString(noun, r.plural)
+	return in.cached(cacheKey{plural: true, gender: gender, word: noun}, in.pluralizeNoun)
+}
+
+// SingularizeGender is Singularize with a hint of the grammatical gender of
+// noun.
+func (in *Inflector) SingularizeGender(noun string, gender Gender) string {
+	in.mu.RLock()
+	defer in.mu.RUnlock()
+
+	return in.cached(cacheKey{plural: false, gender: gender, word: noun}, in.applySingulars)
+}
+
+// IsPlural reports whether noun is already plural: an uncountable, the plural
+// of an irregular, or a word that the plural rules leave unchanged and the
+// singular rules do not, such as "statuses".
+func (in *Inflector) IsPlural(noun string) bool {
+	in.mu.RLock()
+	defer in.mu.RUnlock()
+
+	return in.looksPlural(noun, NoGender)
+}
+
+// IsSingular reports whether noun is singular. Uncountables and irregulars
+// whose forms coincide, such as "sheep", are both singular and plural.
+func (in *Inflector) IsSingular(noun string) bool {
+	in.mu.RLock()
+	defer in.mu.RUnlock()
+
+	return in.isUncountable(noun, NoGender) || in.isIrregularSingular(noun, NoGender) || !in.looksPlural(noun, NoGender)
+}
+
+func (in *Inflector) looksPlural(noun string, gender Gender) bool {
+	if in.isPlural(noun, gender) {
+		return true
+	}
+
+	return !in.isIrregularSingular(noun, gender) && in.applyPlurals(noun, gender) == noun && in.applySingulars(noun, gender) != noun
+}
+
+// isPlural reports whether Pluralize must leave noun alone: an uncountable or
+// the plural of an irregular, as a whole word. Regular plurals need no such
+// check, since the plural rules leave them unchanged.
+func (in *Inflector) isPlural(noun string, gender Gender) bool {
+	return in.isUncountable(noun, gender) || in.isIrregularPlural(noun, gender)
+}
+
+func (in *Inflector) isUncountable(noun string, gender Gender) bool {
+	for _, i := range in.pluralIndex.lookup(noun) {
+		if r := in.pluralize[i]; r.table == UncountableTable && r.appliesTo(gender) && r.singularRe.MatchString(noun) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// isIrregularSingular and isIrregularPlural report whether noun is a form of
+// an irregular, as the entire noun or the last segment of a delimited
+// identifier such as "old_people". Nouns that merely end with one, such as
+// "slice" or "omen", are left to the other rules.
+func (in *Inflector) isIrregularSingular(noun string, gender Gender) bool {
+	for _, i := range in.pluralIndex.lookup(noun) {
+		if r := in.pluralize[i]; r.table == IrregularTable && r.appliesTo(gender) {
+			if loc := r.singularRe.FindStringIndex(noun); loc != nil && startsWord(noun, loc[0]) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+func (in *Inflector) isIrregularPlural(noun string, gender Gender) bool {
+	for _, i := range in.singularIndex.lookup(noun) {
+		if r := in.singularize[i]; r.table == IrregularTable && r.appliesTo(gender) {
+			if loc := r.pluralRe.FindStringIndex(noun); loc != nil && startsWord(noun, loc[0]) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// startsWord reports whether noun has a word starting at i, as wordDelimiter
+// has it.
+func startsWord(noun string, i int) bool {
+	if i == 0 {
+		return true
+	}
+
+	r, _ := utf8.DecodeLastRuneInString(noun[:i])
+
+	return !unicode.IsLetter(r) && !unicode.IsNumber(r)
+}
+
+func (in *Inflector) pluralizeNoun(noun string, gender Gender) string {
+	if in.isPlural(noun, gender) {
+		return noun
+	}
+
+	return in.applyPlurals(noun, gender)
+}
+
+func (in *Inflector) applyPlurals(noun string, gender Gender) string {
+	if in.endsWithAcronym(noun, "") {
+		return noun + "s"
+	}
+
+	for _, i := range in.pluralIndex.lookup(noun) {
+		if r := in.pluralize[i]; r.appliesTo(gender) && r.singularRe.MatchString(noun) {
+			return restoreCase(noun, r.singularRe.ReplaceAllString(noun, r.plural))
+		}
+	}
+
+	return noun
+}
+
+// applySingulars leaves alone a noun that is an uncountable, as a whole word,
+// with an s added, since the uncountable has no plural to come from:
+// "informations" stays as is, while "currencies" becomes "currency".
+func (in *Inflector) applySingulars(noun string, gender Gender) string {
+	if in.endsWithAcronym(noun, "s") {
+		return strings.TrimSuffix(noun, "s")
+	}
+
+	for _, i := range in.singularIndex.lookup(noun) {
+		if r := in.singularize[i]; r.appliesTo(gender) && r.pluralRe.MatchString(noun) {
+			singular := r.pluralRe.ReplaceAllString(noun, r.singular)
+			if in.extendsUncountable(noun, singular, gender) {
+				return noun
+			}
+
+			return restoreCase(noun, singular)
 		}
 	}
 
-	return singular
+	return noun
+}
+
+func (in *Inflector) extendsUncountable(noun, singular string, gender Gender) bool {
+	return noun == singular+"s" && in.isUncountable(singular, gender)
+}
+
+// EnableCache memoizes up to capacity results of Pluralize and Singularize.
+// The cache is cleared whenever rules are added; a capacity of zero or less
+// disables it.
+func (in *Inflector) EnableCache(capacity int) {
+	in.mu.Lock()
+	defer in.mu.Unlock()
+
+	if capacity <= 0 {
+		in.cache = nil
+	} else {
+		in.cache = newLRUCache(capacity)
+	}
 }
 
-func Singularize(noun string) (plural string) {
-	plural = noun
+func (in *Inflector) CacheStats() CacheStatistics {
+	in.mu.RLock()
+	defer in.mu.RUnlock()
+
+	if in.cache == nil {
+		return CacheStatistics{}
+	}
+
+	return in.cache.stats()
+}
+
+func (in *Inflector) cached(key cacheKey, inflect func(string, Gender) string) string {
+	if in.cache == nil {
+		return inflect(key.word, key.gender)
+	}
+
+	if value, ok := in.cache.get(key); ok {
+		return value
+	}
+
+	value := inflect(key.word, key.gender)
+	in.cache.put(key, value)
+
+	return value
+}
 
-	for _, r := range singularize {
-		if r.pluralRe.MatchString(noun) {
-			plural = r.pluralRe.ReplaceAllString(noun, r.singular)
+// AddPlural adds a plural rule that takes precedence over the plural rules
+// already added. Irregulars and uncountables still take precedence over it, so
+// that a broad rule such as "s$" does not change "people" or "sheep".
+func (in *Inflector) AddPlural(rule, replacement string) error {
+	return in.update(func() {
+		in.plurals = append(in.plurals, &Rule{singular: rule, plural: replacement})
+	})
+}
+
+// AddSingular adds a singular rule that, like the rules of AddPlural, takes
+// precedence over the singular rules already added but not over irregulars or
+// uncountables.
+func (in *Inflector) AddSingular(rule, replacement string) error {
+	return in.update(func() {
+		in.singulars = append(in.singulars, &Rule{plural: rule, singular: replacement})
+	})
+}
+
+func (in *Inflector) AddIrregular(singular, plural string) error {
+	return in.update(func() {
+		in.uncountables = removeRules(in.uncountables, singular, plural)
+		in.irregulars = append(removeRules(in.irregulars, singular, plural), &Rule{singular: singular, plural: plural})
+	})
+}
+
+func (in *Inflector) AddUncountable(words ...string) error {
+	return in.update(func() {
+		for _, word := range words {
+			in.irregulars = removeRules(in.irregulars, word, word)
+			in.uncountables = append(removeRules(in.uncountables, word, word), &Rule{singular: word, plural: word})
+		}
+	})
+}
+
+func (in *Inflector) update(fn func()) error {
+	in.mu.Lock()
+	defer in.mu.Unlock()
+
+	p, s, i, u := in.plurals, in.singulars, in.irregulars, in.uncountables
+
+	fn()
+
+	if err := in.compile(); err != nil {
+		in.plurals, in.singulars, in.irregulars, in.uncountables = p, s, i, u
+		return err
+	}
+
+	if in.cache != nil {
+		in.cache.purge()
+	}
+
+	return nil
+}
+
+func removeRules(table []*Rule, singular, plural string) []*Rule {
+	var kept []*Rule
+
+	for _, r := range table {
+		if strings.EqualFold(r.singular, singular) || strings.EqualFold(r.plural, plural) {
+			continue
 		}
+
+		kept = append(kept, r)
 	}
 
-	return plural
+	return kept
 }
@@ -1,6 +1,7 @@
 package inflection_test
 
 import (
+	"errors"
 	"github.com/stretchr/testify/assert"
 	"github.com/tjimsk/inflection"
 	"testing"
@@ -87,6 +88,51 @@ func TestInflections(t *testing.T) {
 	}
 }
 
+type testData struct {
+	singular string
+	plural   string
+}
+
+// inflections are checked by TestInflectionTable and by the tests of
+// IsPlural, Explain, loading and locales: their plurals are all recognized as
+// such.
+var inflections = []testData{
+	testData{"alga", "algae"},
+	testData{"analysis", "analyses"},
+	testData{"bus", "buses"},
+	testData{"child", "children"},
+	testData{"criterion", "criteria"},
+	testData{"database", "databases"},
+	testData{"datum", "data"},
+	testData{"fish", "fish"},
+	testData{"index", "indices"},
+	testData{"information", "information"},
+	testData{"man", "men"},
+	testData{"medium", "media"},
+	testData{"millennium", "millennia"},
+	testData{"mouse", "mice"},
+	testData{"nebula", "nebulae"},
+	testData{"node_child", "node_children"},
+	testData{"old_news", "old_news"},
+	testData{"ox", "oxen"},
+	testData{"person", "people"},
+	testData{"query", "queries"},
+	testData{"quiz", "quizzes"},
+	testData{"series", "series"},
+	testData{"star", "stars"},
+	testData{"STAR", "STARS"},
+	testData{"status", "statuses"},
+	testData{"wife", "wives"},
+	testData{"woman", "women"},
+}
+
+func TestInflectionTable(t *testing.T) {
+	for _, td := range inflections {
+		testPluralization(t, td.singular, td.plural)
+		testSingularization(t, td.plural, td.singular)
+	}
+}
+
 func testPluralization(t *testing.T, singular string, expected string) {
 	plural := inflection.Pluralize(singular)
 	assert.Equal(t, expected, plural, "wrong plural for %v", singular)
@@ -96,3 +142,279 @@ func testSingularization(t *testing.T, plural string, expected string) {
 	singular := inflection.Singularize(plural)
 	assert.Equal(t, expected, singular, "wrong singular for %v", plural)
 }
+
+func TestAddRules(t *testing.T) {
+	in := inflection.New()
+
+	assert.NoError(t, in.AddIrregular("schema", "schemata"))
+	assert.NoError(t, in.AddUncountable("metadata"))
+	assert.NoError(t, in.AddPlural("(cact)us$", "${1}i"))
+	assert.NoError(t, in.AddSingular("(cact)i$", "${1}us"))
+
+	assert.Equal(t, "schemata", in.Pluralize("schema"))
+	assert.Equal(t, "Schemata", in.Pluralize("Schema"))
+	assert.Equal(t, "SCHEMATA", in.Pluralize("SCHEMA"))
+	assert.Equal(t, "schema", in.Singularize("schemata"))
+	assert.Equal(t, "metadata", in.Pluralize("metadata"))
+	assert.Equal(t, "metadata", in.Singularize("metadata"))
+	assert.Equal(t, "db_schemata", in.Pluralize("db_schema"))
+	assert.Equal(t, "cacti", in.Pluralize("cactus"))
+	assert.Equal(t, "cactus", in.Singularize("cacti"))
+
+	assert.NoError(t, in.AddUncountable("schema"))
+	assert.Equal(t, "schema", in.Pluralize("schema"))
+
+	assert.Error(t, in.AddPlural("(unclosed$", "x"))
+	assert.Error(t, in.AddSingular("(unclosed$", "x"))
+	assert.Equal(t, "stars", in.Pluralize("star"))
+
+	assert.Equal(t, "schemas", inflection.Pluralize("schema"), "the default inflector is left alone")
+}
+
+func TestAddedRulesDoNotOverrideIrregularsOrUncountables(t *testing.T) {
+	in := inflection.New()
+
+	assert.NoError(t, in.AddPlural("$", "z"))
+	assert.NoError(t, in.AddSingular("z$", ""))
+
+	assert.Equal(t, "starz", in.Pluralize("star"))
+	assert.Equal(t, "star", in.Singularize("starz"))
+	assert.Equal(t, "people", in.Pluralize("person"))
+	assert.Equal(t, "person", in.Singularize("people"))
+	assert.Equal(t, "sheep", in.Pluralize("sheep"))
+	assert.Equal(t, "sheep", in.Singularize("sheep"))
+}
+
+func TestAddRulesToDefaultInflector(t *testing.T) {
+	assert.NoError(t, inflection.AddIrregular("glorb", "glorbim"))
+	assert.NoError(t, inflection.AddUncountable("snarf"))
+	assert.NoError(t, inflection.AddPlural("(blint)$", "${1}ae"))
+	assert.NoError(t, inflection.AddSingular("(blint)ae$", "${1}"))
+
+	assert.Equal(t, "glorbim", inflection.Pluralize("glorb"))
+	assert.Equal(t, "glorb", inflection.Singularize("glorbim"))
+	assert.Equal(t, "snarf", inflection.Pluralize("snarf"))
+	assert.Equal(t, "snarf", inflection.Singularize("snarf"))
+	assert.Equal(t, "blintae", inflection.Pluralize("blint"))
+	assert.Equal(t, "blint", inflection.Singularize("blintae"))
+
+	assert.Error(t, inflection.AddPlural("(unclosed$", "x"))
+	assert.Error(t, inflection.AddSingular("(unclosed$", "x"))
+	assert.Equal(t, "stars", inflection.Pluralize("star"))
+
+	assert.Equal(t, "glorbs", inflection.New().Pluralize("glorb"), "new inflectors are left alone")
+}
+
+func TestUncountablesOverrideIrregulars(t *testing.T) {
+	in := inflection.New()
+	assert.NoError(t, in.AddUncountable("metadata"))
+
+	assert.Equal(t, "wildlife", in.Pluralize("wildlife"))
+	assert.Equal(t, "metadata", in.Singularize("metadata"))
+	assert.Equal(t, "knives", in.Pluralize("knife"))
+	assert.Equal(t, "datum", in.Singularize("data"))
+}
+
+func TestInflectorInstances(t *testing.T) {
+	a := inflection.New()
+	b := inflection.New()
+
+	assert.NoError(t, a.AddIrregular("lemma", "lemmata"))
+	assert.NoError(t, b.AddUncountable("staff"))
+
+	assert.Equal(t, "lemmata", a.Pluralize("lemma"))
+	assert.Equal(t, "lemmas", b.Pluralize("lemma"))
+	assert.Equal(t, "lemmas", inflection.Pluralize("lemma"))
+
+	assert.Equal(t, "staffs", a.Pluralize("staff"))
+	assert.Equal(t, "staff", b.Pluralize("staff"))
+	assert.Equal(t, "staffs", inflection.Pluralize("staff"))
+
+	assert.Equal(t, "people", a.Pluralize("person"))
+	assert.Equal(t, "person", b.Singularize("people"))
+}
+
+func TestNewWithRulesErrors(t *testing.T) {
+	_, err := inflection.NewWithRules(inflection.Rules{
+		Plurals:      []*inflection.Rule{inflection.NewRule("([a-z])$", "${1}s")},
+		Uncountables: []*inflection.Rule{inflection.NewRule("sheep", "sheep"), inflection.NewRule("fish(", "fish(")},
+	})
+
+	var ruleErr *inflection.RuleError
+	if assert.True(t, errors.As(err, &ruleErr)) {
+		assert.Equal(t, inflection.UncountableTable, ruleErr.Table)
+		assert.Equal(t, "fish(", ruleErr.Rule.Singular())
+		assert.Error(t, ruleErr.Err)
+		assert.Contains(t, err.Error(), `invalid uncountable rule "fish("`)
+	}
+
+	in, err := inflection.NewWithRules(inflection.Rules{
+		Plurals:    []*inflection.Rule{inflection.NewRule("([a-z])$", "${1}s")},
+		Irregulars: []*inflection.Rule{inflection.NewRule("person", "people")},
+	})
+	if assert.NoError(t, err) {
+		assert.Equal(t, "cats", in.Pluralize("cat"))
+		assert.Equal(t, "People", in.Pluralize("Person"))
+	}
+
+	err = inflection.New().AddPlural("(oops$", "${1}")
+	if assert.True(t, errors.As(err, &ruleErr)) {
+		assert.Equal(t, inflection.PluralTable, ruleErr.Table)
+		assert.Equal(t, "(oops$", ruleErr.Rule.Singular())
+	}
+}
+
+func TestReplacementsAreNotPatterns(t *testing.T) {
+	in := inflection.New()
+
+	assert.NoError(t, in.AddPlural("(plus)$", "${1}+("))
+	assert.NoError(t, in.AddSingular("(plus)\\+\\($", "[${1}]"))
+
+	assert.Equal(t, "plus+(", in.Pluralize("plus"))
+	assert.Equal(t, "[plus]", in.Singularize("plus+("))
+}
+
+func TestUncountableWordBoundaries(t *testing.T) {
+	data := []testData{
+		testData{"chair", "chairs"},
+		testData{"armchair", "armchairs"},
+		testData{"repair", "repairs"},
+		testData{"price", "prices"},
+		testData{"device", "devices"},
+		testData{"thousand", "thousands"},
+		testData{"bedroom", "bedrooms"},
+		testData{"network", "networks"},
+		testData{"firework", "fireworks"},
+		testData{"lifetime", "lifetimes"},
+		testData{"grain", "grains"},
+		testData{"train", "trains"},
+		testData{"soil", "soils"},
+		testData{"tablespoon", "tablespoons"},
+		testData{"Chair", "Chairs"},
+		testData{"CHAIR", "CHAIRS"},
+		testData{"hot_air", "hot_air"},
+		testData{"brown-rice", "brown-rice"},
+		testData{"user.information", "user.information"},
+		testData{"sports equipment", "sports equipment"},
+		testData{"Fresh_Water", "Fresh_Water"},
+		testData{"SEA_WATER", "SEA_WATER"},
+		testData{"old age", "old age"},
+		testData{"rice", "rice"},
+		testData{"Rice", "Rice"},
+		testData{"RICE", "RICE"},
+		testData{"aircraft", "aircraft"},
+		testData{"hovercraft", "hovercraft"},
+		testData{"spacecraft", "spacecraft"},
+		testData{"watercraft", "watercraft"},
+		testData{"craft", "crafts"},
+		testData{"handicraft", "handicrafts"},
+		testData{"room", "rooms"},
+		testData{"cheese", "cheeses"},
+		testData{"business", "businesses"},
+		testData{"headquarters", "headquarters"},
+		testData{"company_headquarters", "company_headquarters"},
+		testData{"glove", "gloves"},
+		testData{"prairie", "prairies"},
+		testData{"chateau", "chateaux"},
+		testData{"sandbox", "sandboxes"},
+		testData{"box", "boxes"},
+		testData{"fox", "foxes"},
+		testData{"police", "police"},
+	}
+
+	for _, td := range data {
+		testPluralization(t, td.singular, td.plural)
+		testSingularization(t, td.plural, td.singular)
+	}
+
+	// Singularize leaves alone an uncountable with an s added, which has no
+	// plural to come from, so that such words still round-trip.
+	for _, word := range []string{"airs", "hot_airs", "informations"} {
+		testSingularization(t, word, word)
+		testPluralization(t, word, word)
+	}
+	testSingularization(t, "currencies", "currency")
+}
+
+func TestIsPluralAndIsSingular(t *testing.T) {
+	for _, td := range inflections {
+		if td.singular == td.plural {
+			continue
+		}
+
+		assert.True(t, inflection.IsPlural(td.plural), "%v should be plural", td.plural)
+		assert.False(t, inflection.IsSingular(td.plural), "%v should not be singular", td.plural)
+		assert.True(t, inflection.IsSingular(td.singular), "%v should be singular", td.singular)
+		assert.False(t, inflection.IsPlural(td.singular), "%v should not be plural", td.singular)
+	}
+
+	for _, word := range []string{"sheep", "fish", "series", "equipment", "information", "old_rice", "RICE"} {
+		assert.True(t, inflection.IsPlural(word), "%v should be plural", word)
+		assert.True(t, inflection.IsSingular(word), "%v should be singular", word)
+	}
+
+	for _, word := range []string{"status", "this", "bus", "news", "chair", "person", "slice", "prejudice", "omen", "specimen", "accomplice", "abdomen"} {
+		assert.False(t, inflection.IsPlural(word), "%v should not be plural", word)
+		assert.True(t, inflection.IsSingular(word), "%v should be singular", word)
+	}
+}
+
+func TestPluralizeEndingLikeIrregularPlural(t *testing.T) {
+	data := []testData{
+		testData{"slice", "slices"},
+		testData{"omen", "omens"},
+		testData{"specimen", "specimens"},
+		testData{"regimen", "regimens"},
+		testData{"dolmen", "dolmens"},
+		testData{"lumen", "lumens"},
+		testData{"bitumen", "bitumens"},
+		testData{"cyclamen", "cyclamens"},
+		testData{"semen", "semens"},
+		testData{"chalice", "chalices"},
+		testData{"alice", "alices"},
+		testData{"prejudice", "prejudices"},
+	}
+
+	for _, td := range data {
+		testPluralization(t, td.singular, td.plural)
+		assert.False(t, inflection.IsPlural(td.singular), "%v should not be plural", td.singular)
+	}
+}
+
+func TestPluralizeIsIdempotent(t *testing.T) {
+	for _, td := range inflections {
+		testPluralization(t, td.plural, td.plural)
+	}
+
+	for _, word := range []string{"statuses", "aliases", "people", "old_people", "these", "buses", "mice", "indices", "quizzes"} {
+		testPluralization(t, word, word)
+	}
+}
+
+func TestCaseRestoration(t *testing.T) {
+	data := []testData{
+		testData{"McDonald", "McDonalds"},
+		testData{"McDONALD", "McDONALDS"},
+		testData{"iPhone", "iPhones"},
+		testData{"Café", "Cafés"},
+		testData{"CAFÉ", "CAFÉS"},
+		testData{"SalesPerson", "SalesPeople"},
+		testData{"PERSON", "PEOPLE"},
+		testData{"Person", "People"},
+		testData{"OX", "OXEN"},
+		testData{"Ox", "Oxen"},
+		testData{"MOUSE", "MICE"},
+		testData{"QUIZ", "QUIZZES"},
+		testData{"Node_Child", "Node_Children"},
+		testData{"NODE_CHILD", "NODE_CHILDREN"},
+		testData{"ÉQUIPE", "ÉQUIPES"},
+		testData{"Ärger", "Ärgers"},
+		testData{"Equipment", "Equipment"},
+		testData{"Hot_Air", "Hot_Air"},
+	}
+
+	for _, td := range data {
+		testPluralization(t, td.singular, td.plural)
+		testSingularization(t, td.plural, td.singular)
+	}
+}
@@ -0,0 +1,324 @@
+package inflection
+
+import (
+	"fmt"
+	"regexp"
+	"regexp/syntax"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// maxSamples bounds how many sample words Lint generates from one pattern.
+const maxSamples = 64
+
+// minInverseSample is the length of the shortest words checked for inverse
+// inflections: shorter samples are too far from actual words to tell.
+const minInverseSample = 4
+
+// sampleRunes are the runes tried, in order, for a character class when
+// generating sample words: a mix of vowels and of the consonants that plural
+// rules care about, then a few delimiters.
+var sampleRunes = []rune("abeiousxyzhflmnrtäéñ_ -")
+
+// LintKind is the kind of a LintIssue.
+type LintKind string
+
+const (
+	// RoundTrip is an irregular or uncountable whose own words do not come
+	// back unchanged through Pluralize and Singularize.
+	RoundTrip LintKind = "round trip"
+	// Shadowed is a rule that never wins, since a later rule matches every
+	// word it does.
+	Shadowed LintKind = "shadowed"
+	// NotInverse is a word that Singularize does not bring back from its
+	// plural, or the other way around.
+	NotInverse LintKind = "not inverse"
+)
+
+// LintIssue is a problem in the rules of an Inflector found by Lint.
+type LintIssue struct {
+	Kind  LintKind
+	Table Table
+	Rule  *Rule
+	// By is the later rule that wins over a Shadowed rule.
+	By      *Rule
+	ByTable Table
+	// Word shows the issue: it inflects to Got, where Want was expected.
+	Word string
+	Got  string
+	Want string
+}
+
+func (i LintIssue) String() string {
+	switch i.Kind {
+	case Shadowed:
+		return fmt.Sprintf("%v is shadowed by %v", describe(i.Table, i.Rule), describe(i.ByTable, i.By))
+	case RoundTrip:
+		return fmt.Sprintf("%v %q => %q does not round-trip: %q inflects to %q", i.Table, i.Rule.singular, i.Rule.plural, i.Word, i.Got)
+	}
+
+	return fmt.Sprintf("%v is not inverted: %q inflects to %q and back to %q", describe(i.Table, i.Rule), i.Want, i.Word, i.Got)
+}
+
+// describe writes a rule as its pattern and replacement.
+func describe(table Table, r *Rule) string {
+	if table == SingularTable {
+		return fmt.Sprintf("%v rule %q => %q", table, r.plural, r.singular)
+	}
+
+	return fmt.Sprintf("%v rule %q => %q", table, r.singular, r.plural)
+}
+
+func Lint() []LintIssue {
+	return defaultInflector.Lint()
+}
+
+// Lint checks the rules of in for mistakes:
+//
+//   - every irregular and uncountable must come back unchanged through
+//     Pluralize and Singularize;
+//   - no rule may be shadowed by later rules;
+//   - Singularize must undo what Pluralize does, and the other way around.
+//
+// The last two checks try each rule on sample words generated from its
+// pattern rather than on actual words, so they can miss issues. Words that
+// share an inflection are not reported, as long as the one it inflects back to
+// is its inverse: "matrix" and "matrex" may both pluralize to "matrices".
+func (in *Inflector) Lint() []LintIssue {
+	in.mu.RLock()
+	defer in.mu.RUnlock()
+
+	var issues []LintIssue
+
+	for _, r := range in.irregulars {
+		issues = append(issues, in.lintRoundTrip(IrregularTable, r)...)
+	}
+
+	for _, r := range in.uncountables {
+		issues = append(issues, in.lintRoundTrip(UncountableTable, r)...)
+	}
+
+	issues = append(issues, in.lintShadowed(in.pluralize, true)...)
+	issues = append(issues, in.lintShadowed(in.singularize, false)...)
+	issues = append(issues, in.lintInverses(in.pluralize, true)...)
+	issues = append(issues, in.lintInverses(in.singularize, false)...)
+
+	return issues
+}
+
+func (in *Inflector) lintRoundTrip(table Table, r *Rule) []LintIssue {
+	var issues []LintIssue
+
+	if got := in.pluralizeNoun(r.singular, r.gender); got != r.plural {
+		issues = append(issues, LintIssue{Kind: RoundTrip, Table: table, Rule: r, Word: r.singular, Got: got, Want: r.plural})
+	}
+
+	if got := in.applySingulars(r.plural, r.gender); got != r.singular {
+		issues = append(issues, LintIssue{Kind: RoundTrip, Table: table, Rule: r, Word: r.plural, Got: got, Want: r.singular})
+	}
+
+	return issues
+}
+
+func (in *Inflector) lintShadowed(rules []*Rule, plural bool) []LintIssue {
+	var issues []LintIssue
+
+	for i, r := range rules {
+		re := directionRe(r, plural)
+
+		var by *Rule
+		matched := false
+
+		for _, w := range samples(re) {
+			if !re.MatchString(w) {
+				continue
+			}
+
+			winner := laterMatch(rules[i+1:], w, r.gender, plural)
+			if winner == nil {
+				matched, by = false, nil
+				break
+			}
+
+			// A later rule that inflects the word the same way makes r
+			// redundant rather than wrong.
+			if matched = true; by == nil && inflect(winner, w, plural) != inflect(r, w, plural) {
+				by = winner
+			}
+		}
+
+		if matched && by != nil {
+			issues = append(issues, LintIssue{Kind: Shadowed, Table: r.table, Rule: r.source, By: by.source, ByTable: by.table})
+		}
+	}
+
+	return issues
+}
+
+func laterMatch(rules []*Rule, word string, gender Gender, plural bool) *Rule {
+	for i := len(rules) - 1; i >= 0; i-- {
+		if r := rules[i]; r.appliesTo(gender) && directionRe(r, plural).MatchString(word) {
+			return r
+		}
+	}
+
+	return nil
+}
+
+// lintInverses inflects the sample words of each rule that wins for them and
+// checks that the opposite inflection brings them back.
+func (in *Inflector) lintInverses(rules []*Rule, plural bool) []LintIssue {
+	var issues []LintIssue
+
+	for i, r := range rules {
+		if r.table != PluralTable && r.table != SingularTable {
+			continue
+		}
+
+		for _, w := range samples(directionRe(r, plural)) {
+			if utf8.RuneCountInString(w) < minInverseSample || laterMatch(rules[i:], w, r.gender, plural) != r {
+				continue
+			}
+
+			there := in.inflect(w, r.gender, plural)
+			if there == w || utf8.RuneCountInString(there) < minInverseSample {
+				continue
+			}
+
+			// Several words may share an inflection, as "matrix" and "matrex"
+			// both pluralize to "matrices": that is fine as long as the one
+			// it inflects back to is its inverse.
+			back := in.inflect(there, r.gender, !plural)
+			if back != w && in.inflect(back, r.gender, plural) != there {
+				issues = append(issues, LintIssue{Kind: NotInverse, Table: r.table, Rule: r.source, Word: there, Got: back, Want: w})
+				break
+			}
+		}
+	}
+
+	return issues
+}
+
+func (in *Inflector) inflect(word string, gender Gender, plural bool) string {
+	if plural {
+		return in.pluralizeNoun(word, gender)
+	}
+
+	return in.applySingulars(word, gender)
+}
+
+func inflect(r *Rule, word string, plural bool) string {
+	if plural {
+		return r.singularRe.ReplaceAllString(word, r.plural)
+	}
+
+	return r.pluralRe.ReplaceAllString(word, r.singular)
+}
+
+func directionRe(r *Rule, plural bool) *regexp.Regexp {
+	if plural {
+		return r.singularRe
+	}
+
+	return r.pluralRe
+}
+
+// samples returns words matched by re, built from its literals and from
+// sampleRunes for its character classes.
+func samples(re *regexp.Regexp) []string {
+	parsed, err := syntax.Parse(re.String(), syntax.Perl)
+	if err != nil {
+		return nil
+	}
+
+	return sampleStrings(parsed.Simplify())
+}
+
+func sampleStrings(re *syntax.Regexp) []string {
+	switch re.Op {
+	case syntax.OpLiteral:
+		if re.Flags&syntax.FoldCase != 0 {
+			return []string{strings.ToLower(string(re.Rune))}
+		}
+		return []string{string(re.Rune)}
+	case syntax.OpCharClass:
+		return classSamples(func(r rune) bool {
+			for i := 0; i < len(re.Rune); i += 2 {
+				if r >= re.Rune[i] && r <= re.Rune[i+1] {
+					return true
+				}
+			}
+			return false
+		})
+	case syntax.OpAnyChar, syntax.OpAnyCharNotNL:
+		return classSamples(func(r rune) bool { return unicode.IsLetter(r) })
+	case syntax.OpCapture:
+		return sampleStrings(re.Sub[0])
+	case syntax.OpStar, syntax.OpQuest:
+		return append([]string{""}, sampleStrings(re.Sub[0])...)
+	case syntax.OpPlus:
+		return sampleStrings(re.Sub[0])
+	case syntax.OpAlternate:
+		var set []string
+		for _, sub := range re.Sub {
+			set = append(set, sampleStrings(sub)...)
+		}
+		return truncate(set)
+	case syntax.OpConcat:
+		set := []string{""}
+		for _, sub := range re.Sub {
+			var next []string
+			for _, prefix := range set {
+				for _, s := range sampleStrings(sub) {
+					next = append(next, prefix+s)
+				}
+			}
+			set = truncate(next)
+		}
+		return set
+	}
+
+	return []string{""}
+}
+
+func classSamples(in func(rune) bool) []string {
+	var set []string
+
+	for _, r := range sampleRunes {
+		if in(r) {
+			set = append(set, string(r))
+		}
+	}
+
+	return set
+}
+
+func truncate(set []string) []string {
+	if len(set) > maxSamples {
+		return set[:maxSamples]
+	}
+
+	return set
+}
+
+// TestingT is the part of *testing.T that AssertLint uses.
+type TestingT interface {
+	Helper()
+	Errorf(format string, args ...interface{})
+}
+
+// AssertLint reports every issue that Lint finds in in as an error of t and
+// returns whether there were none, for use in the tests of custom rules:
+//
+//	inflection.AssertLint(t, in)
+func AssertLint(t TestingT, in *Inflector) bool {
+	t.Helper()
+
+	issues := in.Lint()
+	for _, issue := range issues {
+		t.Errorf("inflection: %v", issue)
+	}
+
+	return len(issues) == 0
+}
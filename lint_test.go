@@ -0,0 +1,98 @@
+package inflection_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tjimsk/inflection"
+)
+
+func TestLintBuiltinRules(t *testing.T) {
+	for _, tag := range []string{"en", "en-US", "en-GB", "de", "fr", "pt"} {
+		in, err := inflection.NewLanguage(tag)
+		if assert.NoError(t, err) {
+			inflection.AssertLint(t, in)
+		}
+	}
+}
+
+func TestLintSpanish(t *testing.T) {
+	es, err := inflection.NewLanguage("es")
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	// Oxytones in -és and -ús are irregulars, as Pluralize takes them for
+	// plurals: the singular rules for the others cannot be undone.
+	var patterns []string
+	for _, issue := range es.Lint() {
+		assert.Equal(t, inflection.NotInverse, issue.Kind, "%v", issue)
+		patterns = append(patterns, issue.Rule.Plural())
+	}
+
+	assert.Equal(t, []string{"^(.*[aeiouáéíóúü].*)eses$", "^(.*[aeiouáéíóúü].*)uses$"}, patterns)
+}
+
+func TestLint(t *testing.T) {
+	in, err := inflection.NewWithRules(inflection.Rules{
+		Plurals: []*inflection.Rule{
+			inflection.NewRule(`(\pL)$`, "${1}s"),
+			inflection.NewRule("(child)$", "${1}ren"),
+			inflection.NewRule("(quiz)$", "${1}zes"),
+			inflection.NewRule("(qu)iz$", "${1}izes"),
+		},
+		Singulars: []*inflection.Rule{
+			inflection.NewRule("", "s$"),
+			inflection.NewRule("${1}", "(quiz)zes$"),
+		},
+		Irregulars: []*inflection.Rule{
+			inflection.NewRule("person", "people"),
+			inflection.NewRule("son", "sons"),
+		},
+	})
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	issues := make(map[inflection.LintKind][]string)
+	for _, issue := range in.Lint() {
+		issues[issue.Kind] = append(issues[issue.Kind], issue.String())
+	}
+
+	assert.Equal(t, map[inflection.LintKind][]string{
+		inflection.RoundTrip: []string{
+			`irregular "person" => "people" does not round-trip: "person" inflects to "persons"`,
+		},
+		inflection.Shadowed: []string{
+			`plural rule "(quiz)$" => "${1}zes" is shadowed by plural rule "(qu)iz$" => "${1}izes"`,
+			`irregular rule "person" => "people" is shadowed by irregular rule "son" => "sons"`,
+		},
+		inflection.NotInverse: []string{
+			`plural rule "(child)$" => "${1}ren" is not inverted: "child" inflects to "children" and back to "children"`,
+			`singular rule "(quiz)zes$" => "${1}" is not inverted: "quizzes" inflects to "quiz" and back to "quizes"`,
+		},
+	}, issues)
+}
+
+type recorder struct {
+	errors []string
+}
+
+func (r *recorder) Helper() {}
+
+func (r *recorder) Errorf(format string, args ...interface{}) {
+	r.errors = append(r.errors, fmt.Sprintf(format, args...))
+}
+
+func TestAssertLint(t *testing.T) {
+	rec := &recorder{}
+	assert.True(t, inflection.AssertLint(rec, inflection.New()))
+	assert.Empty(t, rec.errors)
+
+	in := inflection.New()
+	assert.NoError(t, in.AddIrregular("son", "sons"))
+
+	assert.False(t, inflection.AssertLint(rec, in))
+	assert.Contains(t, rec.errors, `inflection: irregular "person" => "people" does not round-trip: "person" inflects to "persons"`)
+}
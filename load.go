@@ -0,0 +1,379 @@
+package inflection
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+
+	"gopkg.in/yaml.v3"
+)
+
+// ruleFile is the layout of the rule files read by LoadJSON and LoadYAML and
+// written by ExportJSON and ExportYAML:
+//
+//	plurals:
+//	  - find: "(quiz)$"
+//	    replace: "${1}zes"
+//	singulars:
+//	  - find: "(quiz)zes$"
+//	    replace: "${1}"
+//	irregulars:
+//	  - singular: person
+//	    plural: people
+//	uncountables: [sheep, metadata]
+//	acronyms: [API, URL]
+//
+// Plural and singular rules and irregulars take an optional gender, one of
+// "masculine", "feminine" or "neuter".
+type ruleFile struct {
+	Plurals      []replaceEntry   `json:"plurals,omitempty" yaml:"plurals,omitempty"`
+	Singulars    []replaceEntry   `json:"singulars,omitempty" yaml:"singulars,omitempty"`
+	Irregulars   []irregularEntry `json:"irregulars,omitempty" yaml:"irregulars,omitempty"`
+	Uncountables []string         `json:"uncountables,omitempty" yaml:"uncountables,omitempty"`
+	Acronyms     []string         `json:"acronyms,omitempty" yaml:"acronyms,omitempty"`
+}
+
+type replaceEntry struct {
+	Find    string `json:"find" yaml:"find"`
+	Replace string `json:"replace" yaml:"replace"`
+	Gender  string `json:"gender,omitempty" yaml:"gender,omitempty"`
+}
+
+type irregularEntry struct {
+	Singular string `json:"singular" yaml:"singular"`
+	Plural   string `json:"plural" yaml:"plural"`
+	Gender   string `json:"gender,omitempty" yaml:"gender,omitempty"`
+}
+
+// LoadError reports an invalid rule file. Line is the line of the offending
+// entry, or zero when it is not known.
+type LoadError struct {
+	Line int
+	Err  error
+}
+
+func (e *LoadError) Error() string {
+	if e.Line == 0 {
+		return fmt.Sprintf("inflection: %v", e.Err)
+	}
+
+	return fmt.Sprintf("inflection: line %d: %v", e.Line, e.Err)
+}
+
+func (e *LoadError) Unwrap() error {
+	return e.Err
+}
+
+func LoadJSON(r io.Reader) error {
+	return defaultInflector.LoadJSON(r)
+}
+
+func LoadYAML(r io.Reader) error {
+	return defaultInflector.LoadYAML(r)
+}
+
+func ExportJSON(w io.Writer) error {
+	return defaultInflector.ExportJSON(w)
+}
+
+func ExportYAML(w io.Writer) error {
+	return defaultInflector.ExportYAML(w)
+}
+
+// LoadJSON reads a rule file in JSON and adds its rules to in as AddPlural,
+// AddSingular, AddIrregular, AddUncountable and AddAcronym would, in that
+// order. Either all of the rules are added or, on error, none of them.
+func (in *Inflector) LoadJSON(r io.Reader) error {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return err
+	}
+
+	var v interface{}
+	if err := json.Unmarshal(data, &v); err != nil {
+		var syntaxErr *json.SyntaxError
+		if errors.As(err, &syntaxErr) {
+			return &LoadError{Line: bytes.Count(data[:syntaxErr.Offset], []byte("\n")) + 1, Err: err}
+		}
+		return &LoadError{Err: err}
+	}
+
+	// JSON is a subset of YAML, whose parser keeps track of lines.
+	return in.load(data)
+}
+
+// LoadYAML is LoadJSON for a rule file in YAML.
+func (in *Inflector) LoadYAML(r io.Reader) error {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return err
+	}
+
+	return in.load(data)
+}
+
+func (in *Inflector) load(data []byte) error {
+	var doc yaml.Node
+	if err := yaml.Unmarshal(data, &doc); err != nil {
+		return &LoadError{Err: err}
+	}
+
+	l := newRuleLoader()
+	if len(doc.Content) > 0 {
+		if err := l.file(doc.Content[0]); err != nil {
+			return err
+		}
+	}
+
+	return in.apply(l)
+}
+
+// apply adds the rules collected by l to in. Rules that fail to compile are
+// reported with the line they came from.
+func (in *Inflector) apply(l *ruleLoader) error {
+	err := in.update(func() {
+		in.plurals = append(in.plurals, l.rules.Plurals...)
+		in.singulars = append(in.singulars, l.rules.Singulars...)
+
+		for _, r := range l.rules.Irregulars {
+			in.uncountables = removeRules(in.uncountables, r.singular, r.plural)
+			in.irregulars = append(removeRules(in.irregulars, r.singular, r.plural), r)
+		}
+
+		for _, r := range l.rules.Uncountables {
+			in.irregulars = removeRules(in.irregulars, r.singular, r.plural)
+			in.uncountables = append(removeRules(in.uncountables, r.singular, r.plural), r)
+		}
+	})
+
+	var ruleErr *RuleError
+	if errors.As(err, &ruleErr) {
+		return &LoadError{Line: l.lines[ruleErr.Rule], Err: err}
+	} else if err != nil {
+		return err
+	}
+
+	if len(l.acronyms) > 0 {
+		in.AddAcronym(l.acronyms...)
+	}
+
+	return nil
+}
+
+// ruleLoader collects the rules of a rule file, remembering the line each
+// rule came from to report errors found when compiling them.
+type ruleLoader struct {
+	rules    Rules
+	acronyms []string
+	lines    map[*Rule]int
+}
+
+func newRuleLoader() *ruleLoader {
+	return &ruleLoader{lines: make(map[*Rule]int)}
+}
+
+func (l *ruleLoader) file(root *yaml.Node) error {
+	if root.Kind == yaml.ScalarNode && root.Tag == "!!null" {
+		return nil
+	}
+
+	if root.Kind != yaml.MappingNode {
+		return &LoadError{Line: root.Line, Err: errors.New("rule file must be a mapping")}
+	}
+
+	for i := 0; i < len(root.Content); i += 2 {
+		key, value := root.Content[i], root.Content[i+1]
+
+		switch key.Value {
+		case "plurals", "singulars", "irregulars", "uncountables", "acronyms":
+		default:
+			return &LoadError{Line: key.Line, Err: fmt.Errorf("unknown table %q", key.Value)}
+		}
+
+		if value.Kind == yaml.ScalarNode && value.Tag == "!!null" {
+			continue
+		}
+
+		if value.Kind != yaml.SequenceNode {
+			return &LoadError{Line: value.Line, Err: fmt.Errorf("%v must be a list", key.Value)}
+		}
+
+		for _, item := range value.Content {
+			if err := l.entry(key, item); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func (l *ruleLoader) entry(key, item *yaml.Node) error {
+	switch key.Value {
+	case "plurals", "singulars":
+		f, err := fields(item, "find", "replace", "gender")
+		if err != nil {
+			return err
+		}
+
+		if f["find"] == "" {
+			return &LoadError{Line: item.Line, Err: fmt.Errorf("%v entry without find", key.Value)}
+		}
+
+		gender, err := parseGender(item, f["gender"])
+		if err != nil {
+			return err
+		}
+
+		if key.Value == "plurals" {
+			l.rules.Plurals = append(l.rules.Plurals, l.rule(item.Line, &Rule{singular: f["find"], plural: f["replace"], gender: gender}))
+		} else {
+			l.rules.Singulars = append(l.rules.Singulars, l.rule(item.Line, &Rule{plural: f["find"], singular: f["replace"], gender: gender}))
+		}
+	case "irregulars":
+		f, err := fields(item, "singular", "plural", "gender")
+		if err != nil {
+			return err
+		}
+
+		if f["singular"] == "" || f["plural"] == "" {
+			return &LoadError{Line: item.Line, Err: errors.New("irregular entry needs both singular and plural")}
+		}
+
+		gender, err := parseGender(item, f["gender"])
+		if err != nil {
+			return err
+		}
+
+		l.rules.Irregulars = append(l.rules.Irregulars, l.rule(item.Line, &Rule{singular: f["singular"], plural: f["plural"], gender: gender}))
+	case "uncountables", "acronyms":
+		if item.Kind != yaml.ScalarNode || item.Value == "" {
+			return &LoadError{Line: item.Line, Err: fmt.Errorf("%v entry must be a word", key.Value)}
+		}
+
+		if key.Value == "uncountables" {
+			l.rules.Uncountables = append(l.rules.Uncountables, l.rule(item.Line, &Rule{singular: item.Value, plural: item.Value}))
+		} else {
+			l.acronyms = append(l.acronyms, item.Value)
+		}
+	}
+
+	return nil
+}
+
+func (l *ruleLoader) rule(line int, r *Rule) *Rule {
+	l.lines[r] = line
+
+	return r
+}
+
+// fields returns the scalar values of a mapping node, which may only have the
+// given keys.
+func fields(node *yaml.Node, keys ...string) (map[string]string, error) {
+	if node.Kind != yaml.MappingNode {
+		return nil, &LoadError{Line: node.Line, Err: fmt.Errorf("entry must be a mapping of %v", keys)}
+	}
+
+	values := make(map[string]string)
+
+	for i := 0; i < len(node.Content); i += 2 {
+		key, value := node.Content[i], node.Content[i+1]
+
+		known := false
+		for _, k := range keys {
+			known = known || key.Value == k
+		}
+
+		if !known {
+			return nil, &LoadError{Line: key.Line, Err: fmt.Errorf("unknown field %q", key.Value)}
+		}
+
+		if value.Kind != yaml.ScalarNode {
+			return nil, &LoadError{Line: value.Line, Err: fmt.Errorf("%v must be a string", key.Value)}
+		}
+
+		if value.Tag != "!!null" {
+			values[key.Value] = value.Value
+		}
+	}
+
+	return values, nil
+}
+
+func parseGender(node *yaml.Node, name string) (Gender, error) {
+	if name == "" {
+		return NoGender, nil
+	}
+
+	for _, g := range []Gender{Masculine, Feminine, Neuter} {
+		if g.String() == name {
+			return g, nil
+		}
+	}
+
+	return NoGender, &LoadError{Line: node.Line, Err: fmt.Errorf("unknown gender %q", name)}
+}
+
+// ExportJSON writes the rules of in as a rule file in JSON, which LoadJSON
+// reads back into an equivalent Inflector: New().ExportJSON(w) dumps the
+// built-in English tables.
+func (in *Inflector) ExportJSON(w io.Writer) error {
+	enc := json.NewEncoder(w)
+	enc.SetEscapeHTML(false)
+	enc.SetIndent("", "  ")
+
+	return enc.Encode(in.ruleFile())
+}
+
+// ExportYAML is ExportJSON for YAML.
+func (in *Inflector) ExportYAML(w io.Writer) error {
+	enc := yaml.NewEncoder(w)
+	enc.SetIndent(2)
+
+	if err := enc.Encode(in.ruleFile()); err != nil {
+		return err
+	}
+
+	return enc.Close()
+}
+
+func (in *Inflector) ruleFile() *ruleFile {
+	in.mu.RLock()
+	defer in.mu.RUnlock()
+
+	f := &ruleFile{}
+
+	for _, r := range in.plurals {
+		f.Plurals = append(f.Plurals, replaceEntry{Find: r.singular, Replace: r.plural, Gender: genderName(r.gender)})
+	}
+
+	for _, r := range in.singulars {
+		f.Singulars = append(f.Singulars, replaceEntry{Find: r.plural, Replace: r.singular, Gender: genderName(r.gender)})
+	}
+
+	for _, r := range in.irregulars {
+		f.Irregulars = append(f.Irregulars, irregularEntry{Singular: r.singular, Plural: r.plural, Gender: genderName(r.gender)})
+	}
+
+	for _, r := range in.uncountables {
+		f.Uncountables = append(f.Uncountables, r.singular)
+	}
+
+	for _, acronym := range in.acronyms {
+		f.Acronyms = append(f.Acronyms, acronym)
+	}
+	sort.Strings(f.Acronyms)
+
+	return f
+}
+
+func genderName(g Gender) string {
+	if g == NoGender {
+		return ""
+	}
+
+	return g.String()
+}
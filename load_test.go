@@ -0,0 +1,152 @@
+package inflection_test
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tjimsk/inflection"
+)
+
+const domainYAML = `# domain vocabulary
+plurals:
+  - find: "(cact)us$"
+    replace: "${1}i"
+singulars:
+  - find: "(cact)i$"
+    replace: "${1}us"
+irregulars:
+  - singular: widget
+    plural: widgetry
+  - {singular: Band, plural: Bands, gender: feminine}
+uncountables:
+  - metadata
+  - sheep
+acronyms: [SKU, API]
+`
+
+const domainJSON = `{
+	"plurals": [{"find": "(cact)us$", "replace": "${1}i"}],
+	"singulars": [{"find": "(cact)i$", "replace": "${1}us"}],
+	"irregulars": [
+		{"singular": "widget", "plural": "widgetry"},
+		{"singular": "Band", "plural": "Bands", "gender": "feminine"}
+	],
+	"uncountables": ["metadata", "sheep"],
+	"acronyms": ["SKU", "API"]
+}`
+
+func TestLoad(t *testing.T) {
+	loaders := map[string]func(*inflection.Inflector) error{
+		"yaml": func(in *inflection.Inflector) error { return in.LoadYAML(strings.NewReader(domainYAML)) },
+		"json": func(in *inflection.Inflector) error { return in.LoadJSON(strings.NewReader(domainJSON)) },
+	}
+
+	for format, load := range loaders {
+		in := inflection.New()
+		if !assert.NoError(t, load(in), format) {
+			continue
+		}
+
+		assert.Equal(t, "cacti", in.Pluralize("cactus"), format)
+		assert.Equal(t, "cactus", in.Singularize("cacti"), format)
+		assert.Equal(t, "widgetry", in.Pluralize("widget"), format)
+		assert.Equal(t, "metadata", in.Pluralize("metadata"), format)
+		assert.Equal(t, "SKUs", in.Pluralize("SKU"), format)
+		assert.Equal(t, "Bands", in.PluralizeGender("Band", inflection.Feminine), format)
+		assert.Equal(t, "people", in.Pluralize("person"), format)
+	}
+
+	assert.Equal(t, "widgets", inflection.Pluralize("widget"))
+}
+
+func TestLoadErrors(t *testing.T) {
+	data := []struct {
+		format string
+		input  string
+		line   int
+		err    string
+	}{
+		{"yaml", "plurals:\n  - find: \"(oops$\"\n    replace: x\n", 2, `invalid plural rule "(oops$"`},
+		{"yaml", "irregulars:\n  - singular: a\n    plural: b\n  - singular: c\n", 4, "needs both singular and plural"},
+		{"yaml", "plurals:\n  - find: x$\n    replacement: y\n", 3, `unknown field "replacement"`},
+		{"yaml", "uncountables: [rice]\nplurls: []\n", 2, `unknown table "plurls"`},
+		{"yaml", "uncountables:\n  - rice\n  - [beans]\n", 3, "must be a word"},
+		{"yaml", "irregulars:\n  - {singular: a, plural: b, gender: common}\n", 2, `unknown gender "common"`},
+		{"yaml", "uncountables: rice\n", 1, "uncountables must be a list"},
+		{"yaml", "- rice\n", 1, "must be a mapping"},
+		{"yaml", "singulars:\n  - replace: y\n", 2, "singulars entry without find"},
+		{"json", "{\n  \"uncountables\": [\"rice\",]\n}", 2, "invalid character"},
+		{"json", "{\n  \"uncountables\": [\"rice\"],\n  \"irregulars\": [\n    {\"singular\": \"fish(\", \"plural\": \"fish(\"}\n  ]\n}", 4, `invalid irregular rule "fish("`},
+	}
+
+	for _, td := range data {
+		in := inflection.New()
+
+		var err error
+		if td.format == "json" {
+			err = in.LoadJSON(strings.NewReader(td.input))
+		} else {
+			err = in.LoadYAML(strings.NewReader(td.input))
+		}
+
+		var loadErr *inflection.LoadError
+		if assert.True(t, errors.As(err, &loadErr), "%q: %v", td.input, err) {
+			assert.Equal(t, td.line, loadErr.Line, td.input)
+			assert.Contains(t, err.Error(), td.err, td.input)
+		}
+
+		assert.Equal(t, "stars", in.Pluralize("star"), td.input)
+	}
+
+	in := inflection.New()
+	assert.Error(t, in.LoadYAML(strings.NewReader("uncountables: [stars]\nplurals:\n  - find: \"(\"\n    replace: x\n")))
+	assert.Equal(t, "stars", in.Pluralize("star"))
+}
+
+func TestExport(t *testing.T) {
+	exporters := map[string]struct {
+		export func(*inflection.Inflector, *bytes.Buffer) error
+		load   func(*inflection.Inflector, *bytes.Buffer) error
+	}{
+		"yaml": {
+			func(in *inflection.Inflector, b *bytes.Buffer) error { return in.ExportYAML(b) },
+			func(in *inflection.Inflector, b *bytes.Buffer) error { return in.LoadYAML(b) },
+		},
+		"json": {
+			func(in *inflection.Inflector, b *bytes.Buffer) error { return in.ExportJSON(b) },
+			func(in *inflection.Inflector, b *bytes.Buffer) error { return in.LoadJSON(b) },
+		},
+	}
+
+	for format, e := range exporters {
+		var b bytes.Buffer
+
+		en := inflection.New()
+		en.AddAcronym("API")
+		if !assert.NoError(t, e.export(en, &b), format) {
+			continue
+		}
+
+		exported := b.String()
+		assert.Contains(t, exported, "octopi", format)
+		assert.Contains(t, exported, "API", format)
+
+		in, err := inflection.NewWithRules(inflection.Rules{})
+		if !assert.NoError(t, err) || !assert.NoError(t, e.load(in, &b), format) {
+			continue
+		}
+
+		for _, td := range inflections {
+			assert.Equal(t, td.plural, in.Pluralize(td.singular), "%v: wrong plural for %v", format, td.singular)
+			assert.Equal(t, td.singular, in.Singularize(td.plural), "%v: wrong singular for %v", format, td.plural)
+		}
+		assert.Equal(t, "APIs", in.Pluralize("API"), format)
+
+		var again bytes.Buffer
+		assert.NoError(t, e.export(in, &again), format)
+		assert.Equal(t, exported, again.String(), format)
+	}
+}
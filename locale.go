@@ -0,0 +1,134 @@
+package inflection
+
+import (
+	"fmt"
+	"sync"
+
+	"golang.org/x/text/language"
+)
+
+var (
+	localesMu sync.Mutex
+
+	english = Rules{Plurals: englishPlurals, Singulars: englishSingulars, Irregulars: englishIrregulars, Uncountables: englishUncountables}
+
+	locales = map[language.Tag]Rules{
+		language.English:         english,
+		language.AmericanEnglish: variant(english, nil, americanEnglishUncountables),
+		language.BritishEnglish:  variant(english, britishEnglishIrregulars, britishEnglishUncountables),
+		language.German:          Rules{Plurals: germanPlurals, Singulars: germanSingulars, Irregulars: germanIrregulars, Uncountables: germanUncountables},
+		language.Spanish:         Rules{Plurals: spanishPlurals, Singulars: spanishSingulars, Irregulars: spanishIrregulars, Uncountables: spanishUncountables},
+		language.French:          Rules{Plurals: frenchPlurals, Singulars: frenchSingulars, Irregulars: frenchIrregulars, Uncountables: frenchUncountables},
+		language.Portuguese:      Rules{Plurals: portuguesePlurals, Singulars: portugueseSingulars, Irregulars: portugueseIrregulars, Uncountables: portugueseUncountables},
+	}
+
+	localeInflectors = make(map[language.Tag]*Inflector)
+)
+
+// For returns the shared Inflector of the closest registered match for tag,
+// falling back through its parents, such as pt-BR to pt, and finally to
+// English. The English Inflector is the one behind the package functions, so
+// rules added to it through For or AddIrregular are seen by both.
+func For(tag language.Tag) *Inflector {
+	localesMu.Lock()
+	defer localesMu.Unlock()
+
+	match, ok := matchLocale(tag)
+	if !ok {
+		match = language.English
+	}
+
+	in, ok := localeInflectors[match]
+	if !ok {
+		var err error
+		if in, err = NewWithRules(locales[match]); err != nil {
+			panic(err)
+		}
+		localeInflectors[match] = in
+	}
+
+	return in
+}
+
+// Register adds or replaces the rules that For uses for tag and the tags
+// that fall back to it. The rules of English, which the package functions
+// use, cannot be replaced: add to them through For(language.English) instead.
+func Register(tag language.Tag, rules Rules) error {
+	if tag == language.English {
+		return fmt.Errorf("inflection: cannot replace the rules of the default language %v", tag)
+	}
+
+	in, err := NewWithRules(rules)
+	if err != nil {
+		return err
+	}
+
+	localesMu.Lock()
+	defer localesMu.Unlock()
+
+	locales[tag] = rules
+	localeInflectors[tag] = in
+
+	return nil
+}
+
+// NewLanguage returns a new Inflector with the rules of the closest
+// registered match for a tag such as "es" or "es-MX". Unlike For, it does not
+// fall back to English.
+func NewLanguage(tag string) (*Inflector, error) {
+	t, err := language.Parse(tag)
+	if err != nil {
+		return nil, fmt.Errorf("inflection: invalid language tag %q: %w", tag, err)
+	}
+
+	localesMu.Lock()
+	match, ok := matchLocale(t)
+	rules := locales[match]
+	localesMu.Unlock()
+
+	if !ok {
+		return nil, fmt.Errorf("inflection: unsupported language %q", tag)
+	}
+
+	return NewWithRules(rules)
+}
+
+func matchLocale(tag language.Tag) (language.Tag, bool) {
+	for t := tag; ; t = t.Parent() {
+		if _, ok := locales[t]; ok {
+			return t, true
+		}
+
+		if t.IsRoot() {
+			break
+		}
+	}
+
+	base, _ := tag.Base()
+	if t := language.Make(base.String()); t != tag {
+		if _, ok := locales[t]; ok {
+			return t, true
+		}
+	}
+
+	return language.Und, false
+}
+
+// variant returns the rules of base with the irregulars and uncountables of a
+// regional variant added, each replacing the entries of base for the same
+// words as AddIrregular and AddUncountable would. base is left unchanged.
+func variant(base Rules, irregulars, uncountables []*Rule) Rules {
+	v := base
+
+	for _, r := range irregulars {
+		v.Uncountables = removeRules(v.Uncountables, r.singular, r.plural)
+		v.Irregulars = append(removeRules(v.Irregulars, r.singular, r.plural), r)
+	}
+
+	for _, r := range uncountables {
+		v.Irregulars = removeRules(v.Irregulars, r.singular, r.plural)
+		v.Uncountables = append(removeRules(v.Uncountables, r.singular, r.plural), r)
+	}
+
+	return v
+}
@@ -0,0 +1,152 @@
+package inflection_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tjimsk/inflection"
+	"golang.org/x/text/language"
+)
+
+func TestFor(t *testing.T) {
+	data := []struct {
+		tag      string
+		singular string
+		plural   string
+	}{
+		{"pt-BR", "ação", "ações"},
+		{"pt-PT", "papel", "papéis"},
+		{"pt", "mês", "meses"},
+		{"es-MX", "canción", "canciones"},
+		{"es-419", "canción", "canciones"},
+		{"fr-CA", "cheval", "chevaux"},
+		{"de-CH", "Apfel", "Äpfel"},
+		{"de-Latn-AT", "Apfel", "Äpfel"},
+		{"en", "person", "people"},
+		{"en-AU", "person", "people"},
+		{"tlh", "person", "people"},
+		{"und", "person", "people"},
+	}
+
+	for _, td := range data {
+		in := inflection.For(language.MustParse(td.tag))
+		assert.Equal(t, td.plural, in.Pluralize(td.singular), td.tag)
+		assert.Equal(t, td.singular, in.Singularize(td.plural), td.tag)
+	}
+
+	assert.Same(t, inflection.For(language.Portuguese), inflection.For(language.BrazilianPortuguese))
+	assert.Same(t, inflection.For(language.English), inflection.For(language.MustParse("tlh")))
+	assert.NotSame(t, inflection.For(language.English), inflection.For(language.Spanish))
+}
+
+func TestEnglishVariants(t *testing.T) {
+	us := inflection.For(language.AmericanEnglish)
+	gb := inflection.For(language.BritishEnglish)
+	en := inflection.For(language.English)
+
+	data := []struct {
+		in       *inflection.Inflector
+		singular string
+		plural   string
+	}{
+		{en, "formula", "formulas"},
+		{us, "formula", "formulas"},
+		{gb, "formula", "formulae"},
+		{gb, "Formula", "Formulae"},
+		{gb, "penny", "pence"},
+		{us, "penny", "pennies"},
+		{gb, "maths", "maths"},
+		{us, "math", "math"},
+		{us, "humor", "humor"},
+		{us, "labor", "labor"},
+		{gb, "humour", "humour"},
+		{gb, "jewellery", "jewellery"},
+		{us, "jewelry", "jewelry"},
+		{us, "person", "people"},
+		{gb, "person", "people"},
+		{gb, "datum", "data"},
+	}
+
+	for _, td := range data {
+		assert.Equal(t, td.plural, td.in.Pluralize(td.singular), "wrong plural for %v", td.singular)
+		assert.Equal(t, td.singular, td.in.Singularize(td.plural), "wrong singular for %v", td.plural)
+	}
+
+	assert.Same(t, gb, inflection.For(language.MustParse("en-GB-oxendict")))
+	assert.Same(t, en, inflection.For(language.MustParse("en-AU")))
+	assert.Equal(t, "formulas", inflection.Pluralize("formula"))
+
+	in, err := inflection.NewLanguage("en-GB")
+	if assert.NoError(t, err) {
+		assert.Equal(t, "formulae", in.Pluralize("formula"))
+	}
+}
+
+func TestForEnglishIsDefault(t *testing.T) {
+	en := inflection.For(language.English)
+	for _, td := range inflections {
+		assert.Equal(t, inflection.Pluralize(td.singular), en.Pluralize(td.singular), "plural of %v", td.singular)
+		assert.Equal(t, inflection.Singularize(td.plural), en.Singularize(td.plural), "singular of %v", td.plural)
+	}
+
+	err := inflection.Register(language.English, inflection.Rules{Irregulars: []*inflection.Rule{inflection.NewRule("person", "persons")}})
+	assert.EqualError(t, err, "inflection: cannot replace the rules of the default language en")
+	assert.Same(t, en, inflection.For(language.English))
+	assert.Equal(t, "people", inflection.Pluralize("person"))
+
+	in, err := inflection.NewLanguage("en")
+	if assert.NoError(t, err) {
+		assert.NoError(t, in.AddIrregular("codex", "codices"))
+		assert.Equal(t, "codices", in.Pluralize("codex"))
+		assert.Equal(t, "codexes", en.Pluralize("codex"), "NewLanguage does not share the default inflector")
+	}
+}
+
+func TestRegister(t *testing.T) {
+	dutch := inflection.Rules{
+		Plurals:    []*inflection.Rule{inflection.NewRule(`(\pL)$`, "${1}en"), inflection.NewRule("([aiouy])$", "${1}'s")},
+		Singulars:  []*inflection.Rule{inflection.NewRule("", "en$"), inflection.NewRule("${1}", "([aiouy])'s$")},
+		Irregulars: []*inflection.Rule{inflection.NewRule("kind", "kinderen")},
+	}
+
+	// A private use language, so that no other test sees the rules.
+	assert.NoError(t, inflection.Register(language.MustParse("qaa"), dutch))
+
+	nl := inflection.For(language.MustParse("qaa-BE"))
+	assert.Equal(t, "boeken", nl.Pluralize("boek"))
+	assert.Equal(t, "auto's", nl.Pluralize("auto"))
+	assert.Equal(t, "kinderen", nl.Pluralize("kind"))
+	assert.Equal(t, "auto", nl.Singularize("auto's"))
+
+	in, err := inflection.NewLanguage("qaa")
+	if assert.NoError(t, err) {
+		assert.Equal(t, "boeken", in.Pluralize("boek"))
+	}
+
+	err = inflection.Register(language.Afrikaans, inflection.Rules{Plurals: []*inflection.Rule{inflection.NewRule("(", "")}})
+	assert.Error(t, err)
+	assert.Equal(t, "people", inflection.For(language.Afrikaans).Pluralize("person"))
+}
+
+func TestNewLanguage(t *testing.T) {
+	for _, tag := range []string{"es", "ES", "es-MX", "es_419"} {
+		es, err := inflection.NewLanguage(tag)
+		if assert.NoError(t, err, tag) {
+			assert.Equal(t, "canciones", es.Pluralize("canción"), tag)
+		}
+	}
+
+	en, err := inflection.NewLanguage("en-US")
+	if assert.NoError(t, err) {
+		assert.Equal(t, "people", en.Pluralize("person"))
+	}
+
+	_, err = inflection.NewLanguage("tlh")
+	assert.EqualError(t, err, `inflection: unsupported language "tlh"`)
+
+	_, err = inflection.NewLanguage("")
+	assert.Error(t, err)
+
+	_, err = inflection.NewLanguage("en-")
+	assert.Error(t, err)
+}
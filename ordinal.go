@@ -0,0 +1,152 @@
+package inflection
+
+import (
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+var (
+	smallNumbers = []string{
+		"zero", "one", "two", "three", "four", "five", "six", "seven", "eight", "nine",
+		"ten", "eleven", "twelve", "thirteen", "fourteen", "fifteen", "sixteen",
+		"seventeen", "eighteen", "nineteen",
+	}
+	tens = []string{
+		"", "", "twenty", "thirty", "forty", "fifty", "sixty", "seventy", "eighty", "ninety",
+	}
+	scales = []string{
+		"", "thousand", "million", "billion", "trillion", "quadrillion", "quintillion",
+	}
+	irregularOrdinals = map[string]string{
+		"one":    "first",
+		"two":    "second",
+		"three":  "third",
+		"five":   "fifth",
+		"eight":  "eighth",
+		"nine":   "ninth",
+		"twelve": "twelfth",
+	}
+)
+
+// Ordinal returns the suffix that turns n into an ordinal: "st" for 1 and 21,
+// "nd" for 2, "rd" for 23, "th" for 11, 12, 13 and 111.
+func Ordinal(n int) string {
+	return Ordinal64(int64(n))
+}
+
+func Ordinal64(n int64) string {
+	r := n % 100
+	if r < 0 {
+		r = -r
+	}
+
+	return ordinalSuffix(r)
+}
+
+func OrdinalBig(n *big.Int) string {
+	r := new(big.Int).Abs(n)
+
+	return ordinalSuffix(r.Rem(r, big.NewInt(100)).Int64())
+}
+
+// Ordinalize returns n followed by its ordinal suffix, such as "1st" or
+// "23rd".
+func Ordinalize(n int) string {
+	return strconv.Itoa(n) + Ordinal(n)
+}
+
+func Ordinalize64(n int64) string {
+	return strconv.FormatInt(n, 10) + Ordinal64(n)
+}
+
+func OrdinalizeBig(n *big.Int) string {
+	return n.String() + OrdinalBig(n)
+}
+
+// OrdinalWords spells n out as an ordinal: "first", "twenty-third",
+// "one hundred eleventh".
+func OrdinalWords(n int64) string {
+	words := cardinalWords(n)
+
+	i := strings.LastIndexAny(words, " -") + 1
+	last := words[i:]
+
+	switch {
+	case irregularOrdinals[last] != "":
+		last = irregularOrdinals[last]
+	case strings.HasSuffix(last, "y"):
+		last = strings.TrimSuffix(last, "y") + "ieth"
+	default:
+		last += "th"
+	}
+
+	return words[:i] + last
+}
+
+func ordinalSuffix(r int64) string {
+	if r%100 >= 11 && r%100 <= 13 {
+		return "th"
+	}
+
+	switch r % 10 {
+	case 1:
+		return "st"
+	case 2:
+		return "nd"
+	case 3:
+		return "rd"
+	}
+
+	return "th"
+}
+
+func cardinalWords(n int64) string {
+	if n == 0 {
+		return smallNumbers[0]
+	}
+
+	u := uint64(n)
+	if n < 0 {
+		u = -u
+	}
+
+	var groups []string
+	for scale := 0; u > 0; scale++ {
+		if group := u % 1000; group > 0 {
+			words := hundredsWords(group)
+			if scales[scale] != "" {
+				words += " " + scales[scale]
+			}
+			groups = append([]string{words}, groups...)
+		}
+		u /= 1000
+	}
+
+	words := strings.Join(groups, " ")
+	if n < 0 {
+		words = "minus " + words
+	}
+
+	return words
+}
+
+func hundredsWords(n uint64) string {
+	var words []string
+
+	if n >= 100 {
+		words = append(words, smallNumbers[n/100], "hundred")
+		n %= 100
+	}
+
+	switch {
+	case n >= 20 && n%10 != 0:
+		words = append(words, tens[n/10]+"-"+smallNumbers[n%10])
+	case n >= 20:
+		words = append(words, tens[n/10])
+	case n > 0:
+		words = append(words, smallNumbers[n])
+	}
+
+	return strings.Join(words, " ")
+}
@@ -0,0 +1,82 @@
+package inflection_test
+
+import (
+	"math"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tjimsk/inflection"
+)
+
+func TestOrdinalize(t *testing.T) {
+	data := map[int64]string{
+		0:     "0th",
+		1:     "1st",
+		2:     "2nd",
+		3:     "3rd",
+		4:     "4th",
+		10:    "10th",
+		11:    "11th",
+		12:    "12th",
+		13:    "13th",
+		21:    "21st",
+		22:    "22nd",
+		23:    "23rd",
+		101:   "101st",
+		111:   "111th",
+		112:   "112th",
+		1002:  "1002nd",
+		1013:  "1013th",
+		-1:    "-1st",
+		-11:   "-11th",
+		-122:  "-122nd",
+		10003: "10003rd",
+	}
+
+	for n, expected := range data {
+		assert.Equal(t, expected, inflection.Ordinalize64(n))
+		assert.Equal(t, expected, inflection.Ordinalize(int(n)))
+		assert.Equal(t, expected, inflection.OrdinalizeBig(big.NewInt(n)))
+		assert.Equal(t, expected[len(expected)-2:], inflection.Ordinal(int(n)))
+	}
+
+	assert.Equal(t, "-9223372036854775808th", inflection.Ordinalize64(math.MinInt64))
+
+	huge, _ := new(big.Int).SetString("123456789012345678901234567891", 10)
+	assert.Equal(t, "st", inflection.OrdinalBig(huge))
+	assert.Equal(t, "123456789012345678901234567891st", inflection.OrdinalizeBig(huge))
+	assert.Equal(t, "-123456789012345678901234567891st", inflection.OrdinalizeBig(new(big.Int).Neg(huge)))
+}
+
+func TestOrdinalWords(t *testing.T) {
+	data := map[int64]string{
+		0:       "zeroth",
+		1:       "first",
+		2:       "second",
+		3:       "third",
+		4:       "fourth",
+		5:       "fifth",
+		8:       "eighth",
+		9:       "ninth",
+		11:      "eleventh",
+		12:      "twelfth",
+		20:      "twentieth",
+		23:      "twenty-third",
+		40:      "fortieth",
+		99:      "ninety-ninth",
+		100:     "one hundredth",
+		101:     "one hundred first",
+		111:     "one hundred eleventh",
+		1000:    "one thousandth",
+		1012:    "one thousand twelfth",
+		2000000: "two millionth",
+		-3:      "minus third",
+	}
+
+	for n, expected := range data {
+		assert.Equal(t, expected, inflection.OrdinalWords(n))
+	}
+
+	assert.Equal(t, "minus nine quintillion two hundred twenty-three quadrillion three hundred seventy-two trillion thirty-six billion eight hundred fifty-four million seven hundred seventy-five thousand eight hundred eighth", inflection.OrdinalWords(math.MinInt64))
+}
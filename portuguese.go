@@ -0,0 +1,94 @@
+package inflection
+
+// Several Portuguese endings take a different plural depending on where the
+// stress falls, which the spelling shows with an accent elsewhere in the word:
+// "papel" becomes "papéis" but "nível" becomes "níveis", and "ação" becomes
+// "ações" but "órgão" becomes "órgãos". The patterns below look for such an
+// accent, in both directions.
+var portuguesePlurals = []*Rule{
+	&Rule{singular: `(\pL)$`, plural: "${1}s"},
+	&Rule{singular: "s$", plural: "s"},
+	&Rule{singular: "x$", plural: "x"},
+	&Rule{singular: "([rz])$", plural: "${1}es"},
+	&Rule{singular: "m$", plural: "ns"},
+	&Rule{singular: "ão$", plural: "ões"},
+	&Rule{singular: "^(.*[áéíóúâêô].*)ão$", plural: "${1}ãos"},
+	&Rule{singular: "al$", plural: "ais"},
+	&Rule{singular: "el$", plural: "éis"},
+	&Rule{singular: "ol$", plural: "óis"},
+	&Rule{singular: "ul$", plural: "uis"},
+	&Rule{singular: "il$", plural: "is"},
+	&Rule{singular: "^(.*[áéíóúâêô].*)el$", plural: "${1}eis"},
+	&Rule{singular: "^(.*[áéíóúâêô].*)il$", plural: "${1}eis"},
+	&Rule{singular: "ês$", plural: "eses"},
+	&Rule{singular: "^([cp])ão$", plural: "${1}ães"},
+	&Rule{singular: "^(m)ão$", plural: "${1}ãos"},
+}
+
+var portugueseSingulars = []*Rule{
+	&Rule{plural: "s$", singular: ""},
+	&Rule{plural: "([aeiouáéíóúâêô][rz])es$", singular: "${1}"},
+	&Rule{plural: "ns$", singular: "m"},
+	&Rule{plural: "ões$", singular: "ão"},
+	&Rule{plural: "ães$", singular: "ão"},
+	&Rule{plural: "ais$", singular: "al"},
+	&Rule{plural: "éis$", singular: "el"},
+	&Rule{plural: "óis$", singular: "ol"},
+	&Rule{plural: "uis$", singular: "ul"},
+	&Rule{plural: "^(.*[áéíóúâêô].*)eis$", singular: "${1}el"},
+	&Rule{plural: "^([^áéíóúâêô]*)eses$", singular: "${1}ês"},
+}
+
+// Oxytones ending in a stressed vowel and "s", such as "mês" or "inglês",
+// would otherwise be taken for plurals and are listed here.
+var portugueseIrregulars = []*Rule{
+	&Rule{singular: "alemão", plural: "alemães"},
+	&Rule{singular: "barril", plural: "barris"},
+	&Rule{singular: "bênção", plural: "bênçãos"},
+	&Rule{singular: "canil", plural: "canis"},
+	&Rule{singular: "capitão", plural: "capitães"},
+	&Rule{singular: "caráter", plural: "caracteres"},
+	&Rule{singular: "chinês", plural: "chineses"},
+	&Rule{singular: "cidadão", plural: "cidadãos"},
+	&Rule{singular: "cônsul", plural: "cônsules"},
+	&Rule{singular: "cristão", plural: "cristãos"},
+	&Rule{singular: "deus", plural: "deuses"},
+	&Rule{singular: "escocês", plural: "escoceses"},
+	&Rule{singular: "fóssil", plural: "fósseis"},
+	&Rule{singular: "francês", plural: "franceses"},
+	&Rule{singular: "freguês", plural: "fregueses"},
+	&Rule{singular: "funil", plural: "funis"},
+	&Rule{singular: "fuzil", plural: "fuzis"},
+	&Rule{singular: "gás", plural: "gases"},
+	&Rule{singular: "grão", plural: "grãos"},
+	&Rule{singular: "holandês", plural: "holandeses"},
+	&Rule{singular: "inglês", plural: "ingleses"},
+	&Rule{singular: "irlandês", plural: "irlandeses"},
+	&Rule{singular: "irmão", plural: "irmãos"},
+	&Rule{singular: "japonês", plural: "japoneses"},
+	&Rule{singular: "marquês", plural: "marqueses"},
+	&Rule{singular: "mês", plural: "meses"},
+	&Rule{singular: "míssil", plural: "mísseis"},
+	&Rule{singular: "pai", plural: "pais"},
+	&Rule{singular: "país", plural: "países"},
+	&Rule{singular: "perfil", plural: "perfis"},
+	&Rule{singular: "português", plural: "portugueses"},
+	&Rule{singular: "projétil", plural: "projéteis"},
+	&Rule{singular: "réptil", plural: "répteis"},
+	&Rule{singular: "tese", plural: "teses"},
+}
+
+var portugueseUncountables = []*Rule{
+	&Rule{singular: "atlas", plural: "atlas"},
+	&Rule{singular: "bônus", plural: "bônus"},
+	&Rule{singular: "cais", plural: "cais"},
+	&Rule{singular: "férias", plural: "férias"},
+	&Rule{singular: "lápis", plural: "lápis"},
+	&Rule{singular: "óculos", plural: "óculos"},
+	&Rule{singular: "ônibus", plural: "ônibus"},
+	&Rule{singular: "ônus", plural: "ônus"},
+	&Rule{singular: "parabéns", plural: "parabéns"},
+	&Rule{singular: "pires", plural: "pires"},
+	&Rule{singular: "tênis", plural: "tênis"},
+	&Rule{singular: "vírus", plural: "vírus"},
+}
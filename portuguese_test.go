@@ -0,0 +1,86 @@
+package inflection_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tjimsk/inflection"
+)
+
+var portugueseInflections = []testData{
+	testData{"casa", "casas"},
+	testData{"livro", "livros"},
+	testData{"café", "cafés"},
+	testData{"irmã", "irmãs"},
+	testData{"mulher", "mulheres"},
+	testData{"flor", "flores"},
+	testData{"ator", "atores"},
+	testData{"luz", "luzes"},
+	testData{"rapaz", "rapazes"},
+	testData{"padre", "padres"},
+	testData{"torre", "torres"},
+	testData{"homem", "homens"},
+	testData{"jardim", "jardins"},
+	testData{"álbum", "álbuns"},
+	testData{"ação", "ações"},
+	testData{"canção", "canções"},
+	testData{"informação", "informações"},
+	testData{"órgão", "órgãos"},
+	testData{"sótão", "sótãos"},
+	testData{"mão", "mãos"},
+	testData{"irmão", "irmãos"},
+	testData{"pão", "pães"},
+	testData{"alemão", "alemães"},
+	testData{"falcão", "falcões"},
+	testData{"limão", "limões"},
+	testData{"sermão", "sermões"},
+	testData{"pulmão", "pulmões"},
+	testData{"mamão", "mamões"},
+	testData{"cão", "cães"},
+	testData{"balcão", "balcões"},
+	testData{"vulcão", "vulcões"},
+	testData{"Pão", "Pães"},
+	testData{"animal", "animais"},
+	testData{"jornal", "jornais"},
+	testData{"papel", "papéis"},
+	testData{"hotel", "hotéis"},
+	testData{"nível", "níveis"},
+	testData{"automóvel", "automóveis"},
+	testData{"farol", "faróis"},
+	testData{"lençol", "lençóis"},
+	testData{"azul", "azuis"},
+	testData{"barril", "barris"},
+	testData{"fóssil", "fósseis"},
+	testData{"réptil", "répteis"},
+	testData{"mês", "meses"},
+	testData{"português", "portugueses"},
+	testData{"país", "países"},
+	testData{"gás", "gases"},
+	testData{"deus", "deuses"},
+	testData{"pai", "pais"},
+	testData{"rei", "reis"},
+	testData{"síntese", "sínteses"},
+	testData{"lápis", "lápis"},
+	testData{"ônibus", "ônibus"},
+	testData{"tórax", "tórax"},
+	testData{"Ação", "Ações"},
+	testData{"AÇÃO", "AÇÕES"},
+	testData{"Papel", "Papéis"},
+	testData{"MÊS", "MESES"},
+	testData{"nota_fiscal", "nota_fiscais"},
+}
+
+func TestPortugueseInflections(t *testing.T) {
+	pt, err := inflection.NewLanguage("pt-BR")
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	for _, td := range portugueseInflections {
+		assert.Equal(t, td.plural, pt.Pluralize(td.singular), "wrong plural for %v", td.singular)
+		assert.Equal(t, td.singular, pt.Singularize(td.plural), "wrong singular for %v", td.plural)
+		assert.Equal(t, td.plural, pt.Pluralize(td.plural), "plural of %v should be idempotent", td.plural)
+	}
+
+	assert.Equal(t, "camponês", pt.Singularize("camponeses"))
+}
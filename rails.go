@@ -0,0 +1,628 @@
+package inflection
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+var (
+	railsLocaleRe      = regexp.MustCompile(`^\s*(?:ActiveSupport::)?Inflector\.inflections\b(?:\s*\(?\s*:?["']?([\w-]+)["']?)?`)
+	railsDeclarationRe = regexp.MustCompile(`^\s*\w+\.(plural|singular|irregular|uncountable|acronym)\b`)
+	railsRegexpRe      = regexp.MustCompile(`^/(.*)/([a-z]*)$`)
+)
+
+func LoadRails(r io.Reader, locale string) error {
+	return defaultInflector.LoadRails(r, locale)
+}
+
+func LoadRailsYAML(r io.Reader, locale string) error {
+	return defaultInflector.LoadRailsYAML(r, locale)
+}
+
+// LoadRails reads the inflect.plural, inflect.singular, inflect.irregular,
+// inflect.uncountable and inflect.acronym declarations of a Rails initializer
+// such as config/initializers/inflections.rb and adds them to in as LoadJSON
+// does. Only the declarations inside an
+// ActiveSupport::Inflector.inflections block for locale are read, where a
+// block without a locale is for "en". Ruby regexps and replacements such as
+// /^(ox)$/i and '\1en' are translated to their Go equivalents; other
+// declarations, such as inflect.human, are ignored.
+func (in *Inflector) LoadRails(r io.Reader, locale string) error {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return err
+	}
+
+	l := newRuleLoader()
+	p := &rubyParser{src: string(data)}
+	current := "en"
+
+	for ; p.pos < len(p.src); p.nextLine() {
+		line := p.src[p.pos:]
+		if i := strings.IndexByte(line, '\n'); i >= 0 {
+			line = line[:i]
+		}
+
+		if m := railsLocaleRe.FindStringSubmatch(line); m != nil {
+			current = "en"
+			if m[1] != "" && m[1] != "do" {
+				current = m[1]
+			}
+			continue
+		}
+
+		m := railsDeclarationRe.FindStringSubmatchIndex(line)
+		if m == nil || !sameLocale(current, locale) {
+			continue
+		}
+
+		start := p.pos
+		p.pos += m[1]
+
+		args, err := p.args()
+		if err != nil {
+			return err
+		}
+
+		if err := l.railsDeclaration(line[m[2]:m[3]], args, p.line(start)); err != nil {
+			return err
+		}
+	}
+
+	return in.apply(l)
+}
+
+// LoadRailsYAML reads the inflections of locale from a YAML locale file in
+// the layout used with rails-i18n:
+//
+//	en:
+//	  inflections:
+//	    plural:
+//	      - ['(quiz)$', '\1zes']
+//	    singular:
+//	      - ['/(quiz)zes$/i', '\1']
+//	    irregular:
+//	      person: people
+//	    uncountable: [fish, sheep]
+//	    acronym: [API]
+//
+// Patterns may be written as bare Ruby regexps or as /.../ literals with
+// flags. The rules are added to in as LoadJSON does.
+func (in *Inflector) LoadRailsYAML(r io.Reader, locale string) error {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return err
+	}
+
+	var doc yaml.Node
+	if err := yaml.Unmarshal(data, &doc); err != nil {
+		return &LoadError{Err: err}
+	}
+
+	var inflections *yaml.Node
+
+	if len(doc.Content) > 0 && doc.Content[0].Kind == yaml.MappingNode {
+		root := doc.Content[0]
+		for i := 0; i < len(root.Content); i += 2 {
+			if sameLocale(root.Content[i].Value, locale) {
+				inflections = mappingValue(root.Content[i+1], "inflections")
+			}
+		}
+	}
+
+	if inflections == nil {
+		return &LoadError{Err: fmt.Errorf("no inflections for locale %q", locale)}
+	}
+
+	if inflections.Kind != yaml.MappingNode {
+		return &LoadError{Line: inflections.Line, Err: errors.New("inflections must be a mapping")}
+	}
+
+	l := newRuleLoader()
+
+	for i := 0; i < len(inflections.Content); i += 2 {
+		if err := l.railsYAMLTable(inflections.Content[i], inflections.Content[i+1]); err != nil {
+			return err
+		}
+	}
+
+	return in.apply(l)
+}
+
+func (l *ruleLoader) railsYAMLTable(key, value *yaml.Node) error {
+	switch key.Value {
+	case "plural", "singular":
+		if value.Kind != yaml.SequenceNode {
+			return &LoadError{Line: value.Line, Err: fmt.Errorf("%v must be a list of [pattern, replacement] pairs", key.Value)}
+		}
+
+		for _, item := range value.Content {
+			if item.Kind != yaml.SequenceNode || len(item.Content) != 2 {
+				return &LoadError{Line: item.Line, Err: fmt.Errorf("%v entry must be a [pattern, replacement] pair", key.Value)}
+			}
+
+			pattern, flags := item.Content[0].Value, ""
+			if m := railsRegexpRe.FindStringSubmatch(pattern); m != nil {
+				pattern, flags = m[1], m[2]
+			}
+
+			args := []rubyValue{{regexp: true, s: pattern, flags: flags}, {s: item.Content[1].Value}}
+			if err := l.railsDeclaration(key.Value, args, item.Line); err != nil {
+				return err
+			}
+		}
+	case "irregular":
+		pairs := value.Content
+		if value.Kind == yaml.SequenceNode {
+			pairs = nil
+			for _, item := range value.Content {
+				if item.Kind != yaml.SequenceNode || len(item.Content) != 2 {
+					return &LoadError{Line: item.Line, Err: errors.New("irregular entry must be a [singular, plural] pair")}
+				}
+				pairs = append(pairs, item.Content...)
+			}
+		} else if value.Kind != yaml.MappingNode {
+			return &LoadError{Line: value.Line, Err: errors.New("irregular must be a mapping of singulars to plurals")}
+		}
+
+		for i := 0; i < len(pairs); i += 2 {
+			args := []rubyValue{{s: pairs[i].Value}, {s: pairs[i+1].Value}}
+			if err := l.railsDeclaration(key.Value, args, pairs[i].Line); err != nil {
+				return err
+			}
+		}
+	case "uncountable", "acronym":
+		var args []rubyValue
+
+		switch value.Kind {
+		case yaml.ScalarNode:
+			for _, word := range strings.Fields(value.Value) {
+				args = append(args, rubyValue{s: word})
+			}
+		case yaml.SequenceNode:
+			for _, item := range value.Content {
+				args = append(args, rubyValue{s: item.Value})
+			}
+		default:
+			return &LoadError{Line: value.Line, Err: fmt.Errorf("%v must be a list of words", key.Value)}
+		}
+
+		if err := l.railsDeclaration(key.Value, args, value.Line); err != nil {
+			return err
+		}
+	default:
+		return &LoadError{Line: key.Line, Err: fmt.Errorf("unknown inflection %q", key.Value)}
+	}
+
+	return nil
+}
+
+// railsDeclaration adds the rules of a call such as inflect.plural with the
+// given arguments.
+func (l *ruleLoader) railsDeclaration(method string, args []rubyValue, line int) error {
+	errorf := func(format string, a ...interface{}) error {
+		return &LoadError{Line: line, Err: fmt.Errorf("inflect.%v: "+format, append([]interface{}{method}, a...)...)}
+	}
+
+	switch method {
+	case "plural", "singular":
+		if len(args) != 2 || args[0].list != nil || args[1].list != nil || args[1].regexp {
+			return errorf("want a pattern and a replacement")
+		}
+
+		// A string rule matches literally, as in Rails.
+		pattern := regexp.QuoteMeta(args[0].s)
+		if args[0].regexp {
+			var err error
+			if pattern, err = rubyPattern(args[0].s, args[0].flags); err != nil {
+				return errorf("%v", err)
+			}
+		}
+
+		replacement := rubyReplacement(args[1].s)
+
+		if method == "plural" {
+			l.rules.Plurals = append(l.rules.Plurals, l.rule(line, &Rule{singular: pattern, plural: replacement}))
+		} else {
+			l.rules.Singulars = append(l.rules.Singulars, l.rule(line, &Rule{plural: pattern, singular: replacement}))
+		}
+	case "irregular":
+		if len(args) != 2 || args[0].list != nil || args[1].list != nil || args[0].s == "" || args[1].s == "" {
+			return errorf("want a singular and a plural")
+		}
+
+		l.rules.Irregulars = append(l.rules.Irregulars, l.rule(line, &Rule{singular: args[0].s, plural: args[1].s}))
+	case "uncountable", "acronym":
+		for _, arg := range flatten(args) {
+			if arg.regexp || arg.s == "" {
+				return errorf("want words")
+			}
+
+			if method == "uncountable" {
+				l.rules.Uncountables = append(l.rules.Uncountables, l.rule(line, &Rule{singular: arg.s, plural: arg.s}))
+			} else {
+				l.acronyms = append(l.acronyms, arg.s)
+			}
+		}
+	}
+
+	return nil
+}
+
+// rubyPattern translates a Ruby regexp to Go syntax: flags become a (?i) or
+// (?s) prefix, \Z an end anchor, \h a hex digit class and (?<name>) a (?P<name>)
+// group.
+func rubyPattern(src, flags string) (string, error) {
+	var b strings.Builder
+
+	for _, f := range flags {
+		switch f {
+		case 'i':
+			b.WriteString("(?i)")
+		case 'm':
+			b.WriteString("(?s)")
+		case 'x':
+			return "", errors.New("extended regexps are not supported")
+		}
+	}
+
+	for i := 0; i < len(src); i++ {
+		switch {
+		case src[i] == '\\' && i+1 < len(src):
+			switch src[i+1] {
+			case 'Z':
+				b.WriteString("$")
+			case 'h':
+				b.WriteString("[0-9A-Fa-f]")
+			case 'H':
+				b.WriteString("[^0-9A-Fa-f]")
+			default:
+				b.WriteString(src[i : i+2])
+			}
+			i++
+		case strings.HasPrefix(src[i:], "(?<") && !strings.HasPrefix(src[i:], "(?<=") && !strings.HasPrefix(src[i:], "(?<!"):
+			b.WriteString("(?P<")
+			i += 2
+		default:
+			b.WriteByte(src[i])
+		}
+	}
+
+	return b.String(), nil
+}
+
+// rubyReplacement translates the back-references of a Ruby replacement
+// string, \1, \0, \& and \k<name>, to ${1}, ${0} and ${name}.
+func rubyReplacement(s string) string {
+	var b strings.Builder
+
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+
+		switch {
+		case c == '$':
+			b.WriteString("$$")
+		case c == '\\' && i+1 < len(s) && s[i+1] >= '0' && s[i+1] <= '9':
+			fmt.Fprintf(&b, "${%c}", s[i+1])
+			i++
+		case c == '\\' && i+1 < len(s) && s[i+1] == '&':
+			b.WriteString("${0}")
+			i++
+		case c == '\\' && i+1 < len(s) && s[i+1] == '\\':
+			b.WriteByte('\\')
+			i++
+		case strings.HasPrefix(s[i:], `\k<`) && strings.IndexByte(s[i:], '>') > 0:
+			end := i + strings.IndexByte(s[i:], '>')
+			fmt.Fprintf(&b, "${%v}", s[i+3:end])
+			i = end
+		default:
+			b.WriteByte(c)
+		}
+	}
+
+	return b.String()
+}
+
+func sameLocale(a, b string) bool {
+	return strings.EqualFold(strings.Replace(a, "_", "-", -1), strings.Replace(b, "_", "-", -1))
+}
+
+func mappingValue(node *yaml.Node, key string) *yaml.Node {
+	if node.Kind != yaml.MappingNode {
+		return nil
+	}
+
+	for i := 0; i < len(node.Content); i += 2 {
+		if node.Content[i].Value == key {
+			return node.Content[i+1]
+		}
+	}
+
+	return nil
+}
+
+// rubyValue is an argument of an inflect declaration: a string, a regexp
+// literal with its flags or a list such as %w(fish sheep).
+type rubyValue struct {
+	s      string
+	regexp bool
+	flags  string
+	list   []rubyValue
+}
+
+func flatten(values []rubyValue) []rubyValue {
+	var flat []rubyValue
+
+	for _, v := range values {
+		if v.list != nil {
+			flat = append(flat, flatten(v.list)...)
+		} else {
+			flat = append(flat, v)
+		}
+	}
+
+	return flat
+}
+
+// rubyParser reads the literal arguments of Ruby method calls, which is all
+// an inflections initializer needs.
+type rubyParser struct {
+	src string
+	pos int
+}
+
+func (p *rubyParser) line(pos int) int {
+	return strings.Count(p.src[:pos], "\n") + 1
+}
+
+func (p *rubyParser) errorf(format string, a ...interface{}) error {
+	return &LoadError{Line: p.line(p.pos), Err: fmt.Errorf(format, a...)}
+}
+
+func (p *rubyParser) nextLine() {
+	if i := strings.IndexByte(p.src[p.pos:], '\n'); i >= 0 {
+		p.pos += i + 1
+	} else {
+		p.pos = len(p.src)
+	}
+}
+
+func (p *rubyParser) peek() byte {
+	if p.pos < len(p.src) {
+		return p.src[p.pos]
+	}
+
+	return 0
+}
+
+// skip skips blanks and, if newlines is set, line breaks and comments.
+func (p *rubyParser) skip(newlines bool) {
+	for p.pos < len(p.src) {
+		switch c := p.src[p.pos]; {
+		case c == ' ' || c == '\t' || c == '\r':
+			p.pos++
+		case newlines && c == '\n':
+			p.pos++
+		case newlines && c == '#':
+			for p.pos < len(p.src) && p.src[p.pos] != '\n' {
+				p.pos++
+			}
+		default:
+			return
+		}
+	}
+}
+
+// args reads the arguments of a call, with or without parentheses. Without
+// them the arguments end at the end of the line, unless it ends with a comma.
+func (p *rubyParser) args() ([]rubyValue, error) {
+	p.skip(false)
+
+	paren := p.peek() == '('
+	if paren {
+		p.pos++
+	}
+
+	var args []rubyValue
+
+	for !paren || p.more(')') {
+		v, err := p.value()
+		if err != nil {
+			return nil, err
+		}
+		args = append(args, v)
+
+		p.skip(paren)
+		if p.peek() != ',' {
+			break
+		}
+		p.pos++
+		p.skip(true)
+	}
+
+	if paren {
+		if p.peek() != ')' {
+			return nil, p.errorf("expected )")
+		}
+		p.pos++
+	}
+
+	return args, nil
+}
+
+// more skips blanks, line breaks and comments and reports whether a list
+// goes on, that is whether the next byte is not its closing delimiter.
+func (p *rubyParser) more(closing byte) bool {
+	p.skip(true)
+
+	return p.pos < len(p.src) && p.peek() != closing
+}
+
+func (p *rubyParser) value() (rubyValue, error) {
+	rest := p.src[p.pos:]
+
+	switch {
+	case strings.HasPrefix(rest, "'") || strings.HasPrefix(rest, `"`):
+		p.pos++
+		s, err := p.str(rest[0])
+		return rubyValue{s: s}, err
+	case strings.HasPrefix(rest, "/"):
+		p.pos++
+		return p.regexp('/')
+	case strings.HasPrefix(rest, "%r") && len(rest) > 2:
+		p.pos += 3
+		return p.regexp(closing(rest[2]))
+	case (strings.HasPrefix(rest, "%w") || strings.HasPrefix(rest, "%W") || strings.HasPrefix(rest, "%i")) && len(rest) > 2:
+		p.pos += 3
+		body, err := p.until(closing(rest[2]))
+		if err != nil {
+			return rubyValue{}, err
+		}
+		list := []rubyValue{}
+		for _, word := range strings.Fields(body) {
+			list = append(list, rubyValue{s: word})
+		}
+		return rubyValue{list: list}, nil
+	case strings.HasPrefix(rest, "["):
+		p.pos++
+		list := []rubyValue{}
+		for p.more(']') {
+			v, err := p.value()
+			if err != nil {
+				return rubyValue{}, err
+			}
+			list = append(list, v)
+
+			p.skip(true)
+			if p.peek() != ',' {
+				break
+			}
+			p.pos++
+		}
+		if p.peek() != ']' {
+			return rubyValue{}, p.errorf("expected ]")
+		}
+		p.pos++
+		return rubyValue{list: list}, nil
+	case strings.HasPrefix(rest, ":"):
+		p.pos++
+		start := p.pos
+		for p.pos < len(p.src) && (isWordByte(p.src[p.pos]) || p.src[p.pos] == '?' || p.src[p.pos] == '!') {
+			p.pos++
+		}
+		return rubyValue{s: p.src[start:p.pos]}, nil
+	}
+
+	return rubyValue{}, p.errorf("unsupported argument %q", firstToken(rest))
+}
+
+func (p *rubyParser) regexp(delim byte) (rubyValue, error) {
+	src, err := p.until(delim)
+	if err != nil {
+		return rubyValue{}, err
+	}
+
+	start := p.pos
+	for p.pos < len(p.src) && p.src[p.pos] >= 'a' && p.src[p.pos] <= 'z' {
+		p.pos++
+	}
+
+	return rubyValue{s: src, regexp: true, flags: p.src[start:p.pos]}, nil
+}
+
+// until reads up to an unescaped delim, which it consumes. Escapes are kept
+// but for that of delim itself.
+func (p *rubyParser) until(delim byte) (string, error) {
+	var b strings.Builder
+
+	for start := p.pos; p.pos < len(p.src); p.pos++ {
+		switch c := p.src[p.pos]; {
+		case c == '\\' && p.pos+1 < len(p.src):
+			if p.src[p.pos+1] != delim {
+				b.WriteByte(c)
+			}
+			b.WriteByte(p.src[p.pos+1])
+			p.pos++
+		case c == delim:
+			p.pos++
+			return b.String(), nil
+		case c == '\n' && delim != ')' && delim != ']' && delim != '}':
+			p.pos = start
+			return "", p.errorf("unterminated %c", delim)
+		default:
+			b.WriteByte(c)
+		}
+	}
+
+	return "", p.errorf("unterminated %c", delim)
+}
+
+// str reads a quoted string. Single quotes only escape themselves and
+// backslashes; double quotes also know \n and \t and do not support
+// interpolation.
+func (p *rubyParser) str(quote byte) (string, error) {
+	var b strings.Builder
+
+	for ; p.pos < len(p.src); p.pos++ {
+		c := p.src[p.pos]
+
+		switch {
+		case c == quote:
+			p.pos++
+			return b.String(), nil
+		case c == '\\' && p.pos+1 < len(p.src):
+			n := p.src[p.pos+1]
+			switch {
+			case n == quote || n == '\\':
+				b.WriteByte(n)
+			case quote == '\'':
+				b.WriteString(`\` + string(n))
+			case n == 'n':
+				b.WriteByte('\n')
+			case n == 't':
+				b.WriteByte('\t')
+			default:
+				b.WriteByte(n)
+			}
+			p.pos++
+		case c == '#' && quote == '"' && p.pos+1 < len(p.src) && p.src[p.pos+1] == '{':
+			return "", p.errorf("string interpolation is not supported")
+		default:
+			b.WriteByte(c)
+		}
+	}
+
+	return "", p.errorf("unterminated string")
+}
+
+func closing(open byte) byte {
+	switch open {
+	case '(':
+		return ')'
+	case '[':
+		return ']'
+	case '{':
+		return '}'
+	case '<':
+		return '>'
+	}
+
+	return open
+}
+
+func isWordByte(c byte) bool {
+	return c == '_' || c >= '0' && c <= '9' || c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z'
+}
+
+func firstToken(s string) string {
+	if i := strings.IndexAny(s, " \t\r\n,)"); i >= 0 {
+		return s[:i]
+	}
+
+	return s
+}
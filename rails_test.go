@@ -0,0 +1,154 @@
+package inflection_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tjimsk/inflection"
+)
+
+const railsInitializer = `# Be sure to restart your server when you modify this file.
+
+ActiveSupport::Inflector.inflections(:en) do |inflect|
+  inflect.plural /^(ox)$/i, '\1en'
+  inflect.singular(/^(ox)en/i, "\\1")
+  inflect.plural %r{(quiz)\Z}i, '\1zes' # comment
+  inflect.singular /(?<stem>quiz)zes$/i, '\k<stem>'
+  inflect.plural 'cow', 'kine'
+  inflect.irregular 'octopus', 'octopodes'
+  inflect.irregular("cactus",
+                    "cacti")
+  inflect.uncountable %w( fish sheep
+                          metadata )
+  inflect.uncountable 'rice', :equipment
+  inflect.uncountable ["bison", "moose"]
+  inflect.acronym 'API'
+  inflect.acronym "SKU"
+  inflect.human /_cnt$/i, '\1_count'
+  # inflect.irregular 'schema', 'schemata'
+end
+
+ActiveSupport::Inflector.inflections(:es) do |inflect|
+  inflect.plural /([aeiou])$/i, '\1s'
+  inflect.irregular 'el', 'los'
+end
+`
+
+func TestLoadRails(t *testing.T) {
+	in := inflection.New()
+	if !assert.NoError(t, in.LoadRails(strings.NewReader(railsInitializer), "en")) {
+		return
+	}
+
+	data := []testData{
+		testData{"ox", "oxen"},
+		testData{"quiz", "quizzes"},
+		testData{"octopus", "octopodes"},
+		testData{"cactus", "cacti"},
+		testData{"fish", "fish"},
+		testData{"metadata", "metadata"},
+		testData{"equipment", "equipment"},
+		testData{"bison", "bison"},
+		testData{"API", "APIs"},
+		testData{"SKU", "SKUs"},
+		testData{"person", "people"},
+		testData{"schema", "schemas"},
+	}
+
+	for _, td := range data {
+		assert.Equal(t, td.plural, in.Pluralize(td.singular), "wrong plural for %v", td.singular)
+		assert.Equal(t, td.singular, in.Singularize(td.plural), "wrong singular for %v", td.plural)
+	}
+
+	assert.Equal(t, "kine", in.Pluralize("cow"))
+
+	es, err := inflection.NewWithRules(inflection.Rules{})
+	if assert.NoError(t, err) && assert.NoError(t, es.LoadRails(strings.NewReader(railsInitializer), "es")) {
+		assert.Equal(t, "casas", es.Pluralize("casa"))
+		assert.Equal(t, "los", es.Pluralize("el"))
+		assert.Equal(t, "ox", es.Pluralize("ox"))
+	}
+}
+
+func TestLoadRailsDefaultLocale(t *testing.T) {
+	src := "ActiveSupport::Inflector.inflections do |inflect|\n  inflect.irregular 'lemma', 'lemmata'\nend\n"
+
+	in := inflection.New()
+	assert.NoError(t, in.LoadRails(strings.NewReader(src), "en"))
+	assert.Equal(t, "lemmata", in.Pluralize("lemma"))
+
+	in = inflection.New()
+	assert.NoError(t, in.LoadRails(strings.NewReader(src), "pt-BR"))
+	assert.Equal(t, "lemmas", in.Pluralize("lemma"))
+}
+
+const railsYAML = `en:
+  inflections:
+    plural:
+      - ['(quiz)$', '\1zes']
+      - ['/^(ox)$/i', '\1en']
+    singular:
+      - ['(quiz)zes$', '\1']
+      - ['/^(ox)en/i', '\1']
+    irregular:
+      octopus: octopodes
+    uncountable: [metadata, fish]
+    acronym:
+      - API
+pt-BR:
+  inflections:
+    irregular:
+      - [mão, mãos]
+    uncountable: lápis ônibus
+`
+
+func TestLoadRailsYAML(t *testing.T) {
+	in := inflection.New()
+	if assert.NoError(t, in.LoadRailsYAML(strings.NewReader(railsYAML), "en")) {
+		assert.Equal(t, "oxen", in.Pluralize("ox"))
+		assert.Equal(t, "ox", in.Singularize("oxen"))
+		assert.Equal(t, "octopodes", in.Pluralize("octopus"))
+		assert.Equal(t, "metadata", in.Pluralize("metadata"))
+		assert.Equal(t, "APIs", in.Pluralize("API"))
+	}
+
+	pt, err := inflection.NewLanguage("pt")
+	if assert.NoError(t, err) && assert.NoError(t, pt.LoadRailsYAML(strings.NewReader(railsYAML), "pt_BR")) {
+		assert.Equal(t, "mãos", pt.Pluralize("mão"))
+		assert.Equal(t, "lápis", pt.Singularize("lápis"))
+	}
+
+	err = inflection.New().LoadRailsYAML(strings.NewReader(railsYAML), "fr")
+	assert.EqualError(t, err, `inflection: no inflections for locale "fr"`)
+}
+
+func TestLoadRailsErrors(t *testing.T) {
+	data := []struct {
+		src  string
+		line int
+		err  string
+	}{
+		{"inflect.plural /(oops$/i, '\\1'\n", 1, `invalid plural rule "(?i)(oops$"`},
+		{"\ninflect.plural /x$/, 'y', 'z'\n", 2, "inflect.plural: want a pattern and a replacement"},
+		{"\n\ninflect.irregular 'person'\n", 3, "inflect.irregular: want a singular and a plural"},
+		{"inflect.uncountable %w(a b)\ninflect.plural /x$/x, 'y'\n", 2, "extended regexps are not supported"},
+		{"inflect.plural /x$, 'y'\n", 1, "unterminated /"},
+		{"inflect.plural(/x$/, 'y'\n\n", 3, "expected )"},
+		{"inflect.irregular \"#{a}\", 'b'\n", 1, "interpolation"},
+		{"inflect.uncountable WORDS\n", 1, `unsupported argument "WORDS"`},
+		{"inflect.uncountable [\n  'a',\n  'b'\n", 4, "expected ]"},
+	}
+
+	for _, td := range data {
+		in := inflection.New()
+		err := in.LoadRails(strings.NewReader(td.src), "en")
+
+		var loadErr *inflection.LoadError
+		if assert.True(t, errors.As(err, &loadErr), "%q: %v", td.src, err) {
+			assert.Equal(t, td.line, loadErr.Line, td.src)
+			assert.Contains(t, err.Error(), td.err, td.src)
+		}
+	}
+}
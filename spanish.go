@@ -0,0 +1,103 @@
+package inflection
+
+var spanishPlurals = []*Rule{
+	&Rule{singular: `(\pL)$`, plural: "${1}es"},
+	&Rule{singular: "([aeiouáéíóúü])$", plural: "${1}s"},
+	&Rule{singular: "([aeiouü])s$", plural: "${1}s"},
+	&Rule{singular: "z$", plural: "ces"},
+	&Rule{singular: "án$", plural: "anes"},
+	&Rule{singular: "én$", plural: "enes"},
+	&Rule{singular: "ín$", plural: "ines"},
+	&Rule{singular: "ón$", plural: "ones"},
+	&Rule{singular: "ún$", plural: "unes"},
+}
+
+// A plural in "es" after "l", "n" or "r" drops the "es", as "flores" becomes
+// "flor", unless the stem would be a single syllable ending in a diphthong and
+// that consonant, as in "aires" or "bailes": such words end in "e" instead.
+// "cines" is not the plural of "cin" as "fines" is of "fin", and has a rule of
+// its own.
+var spanishSingulars = []*Rule{
+	&Rule{plural: "s$", singular: ""},
+	&Rule{plural: "([aeiouáéíóúü][lrndjy])es$", singular: "${1}"},
+	&Rule{plural: "([aeiouáéíóúü])ces$", singular: "${1}z"},
+	&Rule{plural: "^(.*[aeiouáéíóúü].*)anes$", singular: "${1}án"},
+	&Rule{plural: "^(.*[aeiouáéíóúü].*)enes$", singular: "${1}én"},
+	&Rule{plural: "^(.*[aeiouáéíóúü].*)ines$", singular: "${1}ín"},
+	&Rule{plural: "^(.*[aeiouáéíóúü].*)ones$", singular: "${1}ón"},
+	&Rule{plural: "^(.*[aeiouáéíóúü].*)unes$", singular: "${1}ún"},
+	&Rule{plural: "^(.*[aeiouáéíóúü].*)eses$", singular: "${1}és"},
+	&Rule{plural: "^(.*[aeiouáéíóúü].*)uses$", singular: "${1}ús"},
+	&Rule{plural: "^([^aeiouáéíóúü]*[aeo]i[lnr])es$", singular: "${1}e"},
+	&Rule{plural: "^(c)ines$", singular: "${1}ine"},
+}
+
+// Oxytones ending in a stressed vowel and "s" are listed as irregulars, since
+// "inglés" cannot be told apart from the plural of a word like "café".
+// Irregulars match the end of a noun, so "adiós" comes after "dios".
+var spanishIrregulars = []*Rule{
+	&Rule{singular: "anís", plural: "anises"},
+	&Rule{singular: "autobús", plural: "autobuses"},
+	&Rule{singular: "bien", plural: "bienes"},
+	&Rule{singular: "canon", plural: "cánones"},
+	&Rule{singular: "carácter", plural: "caracteres"},
+	&Rule{singular: "ciprés", plural: "cipreses"},
+	&Rule{singular: "club", plural: "clubes"},
+	&Rule{singular: "compás", plural: "compases"},
+	&Rule{singular: "crimen", plural: "crímenes"},
+	&Rule{singular: "dios", plural: "dioses"},
+	&Rule{singular: "espécimen", plural: "especímenes"},
+	&Rule{singular: "escocés", plural: "escoceses"},
+	&Rule{singular: "examen", plural: "exámenes"},
+	&Rule{singular: "francés", plural: "franceses"},
+	&Rule{singular: "gas", plural: "gases"},
+	&Rule{singular: "holandés", plural: "holandeses"},
+	&Rule{singular: "imagen", plural: "imágenes"},
+	&Rule{singular: "inglés", plural: "ingleses"},
+	&Rule{singular: "interés", plural: "intereses"},
+	&Rule{singular: "irlandés", plural: "irlandeses"},
+	&Rule{singular: "japonés", plural: "japoneses"},
+	&Rule{singular: "joven", plural: "jóvenes"},
+	&Rule{singular: "margen", plural: "márgenes"},
+	&Rule{singular: "marqués", plural: "marqueses"},
+	&Rule{singular: "mes", plural: "meses"},
+	&Rule{singular: "orden", plural: "órdenes"},
+	&Rule{singular: "origen", plural: "orígenes"},
+	&Rule{singular: "país", plural: "países"},
+	&Rule{singular: "portugués", plural: "portugueses"},
+	&Rule{singular: "régimen", plural: "regímenes"},
+	&Rule{singular: "resumen", plural: "resúmenes"},
+	&Rule{singular: "tos", plural: "toses"},
+	&Rule{singular: "virgen", plural: "vírgenes"},
+	&Rule{singular: "volumen", plural: "volúmenes"},
+	&Rule{singular: "adiós", plural: "adioses"},
+}
+
+var spanishUncountables = []*Rule{
+	&Rule{singular: "abrelatas", plural: "abrelatas"},
+	&Rule{singular: "análisis", plural: "análisis"},
+	&Rule{singular: "atlas", plural: "atlas"},
+	&Rule{singular: "caos", plural: "caos"},
+	&Rule{singular: "clímax", plural: "clímax"},
+	&Rule{singular: "crisis", plural: "crisis"},
+	&Rule{singular: "cumpleaños", plural: "cumpleaños"},
+	&Rule{singular: "dosis", plural: "dosis"},
+	&Rule{singular: "énfasis", plural: "énfasis"},
+	&Rule{singular: "hipótesis", plural: "hipótesis"},
+	&Rule{singular: "jueves", plural: "jueves"},
+	&Rule{singular: "lunes", plural: "lunes"},
+	&Rule{singular: "martes", plural: "martes"},
+	&Rule{singular: "miércoles", plural: "miércoles"},
+	&Rule{singular: "oasis", plural: "oasis"},
+	&Rule{singular: "paraguas", plural: "paraguas"},
+	&Rule{singular: "paréntesis", plural: "paréntesis"},
+	&Rule{singular: "sacapuntas", plural: "sacapuntas"},
+	&Rule{singular: "salud", plural: "salud"},
+	&Rule{singular: "sed", plural: "sed"},
+	&Rule{singular: "síntesis", plural: "síntesis"},
+	&Rule{singular: "tenis", plural: "tenis"},
+	&Rule{singular: "tesis", plural: "tesis"},
+	&Rule{singular: "tórax", plural: "tórax"},
+	&Rule{singular: "viernes", plural: "viernes"},
+	&Rule{singular: "virus", plural: "virus"},
+}
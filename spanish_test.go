@@ -0,0 +1,97 @@
+package inflection_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tjimsk/inflection"
+)
+
+var spanishInflections = []testData{
+	testData{"casa", "casas"},
+	testData{"libro", "libros"},
+	testData{"café", "cafés"},
+	testData{"sofá", "sofás"},
+	testData{"menú", "menús"},
+	testData{"árbol", "árboles"},
+	testData{"papel", "papeles"},
+	testData{"mujer", "mujeres"},
+	testData{"ciudad", "ciudades"},
+	testData{"reloj", "relojes"},
+	testData{"rey", "reyes"},
+	testData{"ley", "leyes"},
+	testData{"flor", "flores"},
+	testData{"madre", "madres"},
+	testData{"hombre", "hombres"},
+	testData{"calle", "calles"},
+	testData{"clase", "clases"},
+	testData{"noche", "noches"},
+	testData{"dulce", "dulces"},
+	testData{"luz", "luces"},
+	testData{"lápiz", "lápices"},
+	testData{"vez", "veces"},
+	testData{"pez", "peces"},
+	testData{"voz", "voces"},
+	testData{"juez", "jueces"},
+	testData{"raíz", "raíces"},
+	testData{"canción", "canciones"},
+	testData{"camión", "camiones"},
+	testData{"corazón", "corazones"},
+	testData{"león", "leones"},
+	testData{"don", "dones"},
+	testData{"alemán", "alemanes"},
+	testData{"capitán", "capitanes"},
+	testData{"plan", "planes"},
+	testData{"pan", "panes"},
+	testData{"almacén", "almacenes"},
+	testData{"tren", "trenes"},
+	testData{"jardín", "jardines"},
+	testData{"fin", "fines"},
+	testData{"cine", "cines"},
+	testData{"aire", "aires"},
+	testData{"baile", "bailes"},
+	testData{"fraile", "frailes"},
+	testData{"peine", "peines"},
+	testData{"miel", "mieles"},
+	testData{"sol", "soles"},
+	testData{"atún", "atunes"},
+	testData{"inglés", "ingleses"},
+	testData{"interés", "intereses"},
+	testData{"autobús", "autobuses"},
+	testData{"mes", "meses"},
+	testData{"país", "países"},
+	testData{"dios", "dioses"},
+	testData{"adiós", "adioses"},
+	testData{"joven", "jóvenes"},
+	testData{"examen", "exámenes"},
+	testData{"imagen", "imágenes"},
+	testData{"orden", "órdenes"},
+	testData{"régimen", "regímenes"},
+	testData{"carácter", "caracteres"},
+	testData{"club", "clubes"},
+	testData{"lunes", "lunes"},
+	testData{"miércoles", "miércoles"},
+	testData{"crisis", "crisis"},
+	testData{"análisis", "análisis"},
+	testData{"virus", "virus"},
+	testData{"paraguas", "paraguas"},
+	testData{"cumpleaños", "cumpleaños"},
+	testData{"tórax", "tórax"},
+	testData{"Canción", "Canciones"},
+	testData{"CANCIÓN", "CANCIONES"},
+	testData{"Luz", "Luces"},
+	testData{"LÁPIZ", "LÁPICES"},
+	testData{"nombre_canción", "nombre_canciones"},
+}
+
+func TestSpanishInflections(t *testing.T) {
+	es, err := inflection.NewLanguage("es")
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	for _, td := range spanishInflections {
+		assert.Equal(t, td.plural, es.Pluralize(td.singular), "wrong plural for %v", td.singular)
+		assert.Equal(t, td.singular, es.Singularize(td.plural), "wrong singular for %v", td.plural)
+	}
+}
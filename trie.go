@@ -0,0 +1,216 @@
+package inflection
+
+import (
+	"regexp"
+	"regexp/syntax"
+	"sort"
+	"unicode"
+)
+
+// maxSuffixes bounds how many alternative literal suffixes are indexed for a
+// single rule before falling back to a shorter, less selective suffix.
+const maxSuffixes = 32
+
+// suffixIndex is a trie over reversed, case-folded word endings. Every rule is
+// stored under the literal suffixes its pattern requires at the end of the
+// input, so a lookup only has to try the rules whose suffix the word actually
+// ends with, in time proportional to the length of the word.
+type suffixIndex struct {
+	root *suffixNode
+}
+
+type suffixNode struct {
+	children map[rune]*suffixNode
+	rules    []int
+}
+
+func newSuffixIndex(patterns []*regexp.Regexp) *suffixIndex {
+	x := &suffixIndex{root: &suffixNode{}}
+
+	for i, re := range patterns {
+		for _, suffix := range requiredSuffixes(re.String()) {
+			x.insert(suffix, i)
+		}
+	}
+
+	return x
+}
+
+func (x *suffixIndex) insert(suffix []rune, rule int) {
+	node := x.root
+
+	for i := len(suffix) - 1; i >= 0; i-- {
+		if node.children == nil {
+			node.children = make(map[rune]*suffixNode)
+		}
+
+		child, ok := node.children[suffix[i]]
+		if !ok {
+			child = &suffixNode{}
+			node.children[suffix[i]] = child
+		}
+
+		node = child
+	}
+
+	node.rules = append(node.rules, rule)
+}
+
+// lookup returns the indices of every rule that may match word, highest index
+// first, mirroring the "last matching rule wins" order of the rule tables.
+func (x *suffixIndex) lookup(word string) []int {
+	var rules []int
+
+	runes := []rune(word)
+	node := x.root
+	rules = append(rules, node.rules...)
+
+	for i := len(runes) - 1; i >= 0; i-- {
+		if node = node.children[foldRune(runes[i])]; node == nil {
+			break
+		}
+
+		rules = append(rules, node.rules...)
+	}
+
+	sort.Sort(sort.Reverse(sort.IntSlice(rules)))
+
+	unique := rules[:0]
+	for i, r := range rules {
+		if i == 0 || r != rules[i-1] {
+			unique = append(unique, r)
+		}
+	}
+
+	return unique
+}
+
+// requiredSuffixes returns a set of case-folded literals, one of which must
+// end any string matched by pattern. A pattern that is not anchored at the
+// end, or whose ending cannot be reduced to literals, yields the empty suffix.
+func requiredSuffixes(pattern string) [][]rune {
+	re, err := syntax.Parse(pattern, syntax.Perl)
+	if err != nil {
+		return [][]rune{nil}
+	}
+
+	return anchoredSuffixes(re.Simplify())
+}
+
+func anchoredSuffixes(re *syntax.Regexp) [][]rune {
+	switch re.Op {
+	case syntax.OpCapture:
+		return anchoredSuffixes(re.Sub[0])
+	case syntax.OpAlternate:
+		var set [][]rune
+		for _, sub := range re.Sub {
+			set = append(set, anchoredSuffixes(sub)...)
+		}
+		return set
+	case syntax.OpConcat:
+		last := len(re.Sub) - 1
+		if last < 0 || re.Sub[last].Op != syntax.OpEndText {
+			break
+		}
+		set, _ := concatSuffixes(re.Sub[:last])
+		return set
+	}
+
+	return [][]rune{nil}
+}
+
+// literalSuffixes returns the suffixes of the strings matched by re and
+// whether they are exact, that is whether re matches nothing but them.
+func literalSuffixes(re *syntax.Regexp) ([][]rune, bool) {
+	switch re.Op {
+	case syntax.OpEmptyMatch, syntax.OpBeginText, syntax.OpBeginLine:
+		return [][]rune{nil}, true
+	case syntax.OpLiteral:
+		lit := make([]rune, len(re.Rune))
+		for i, r := range re.Rune {
+			lit[i] = foldRune(r)
+		}
+		return [][]rune{lit}, true
+	case syntax.OpCharClass:
+		return classSuffixes(re)
+	case syntax.OpCapture:
+		return literalSuffixes(re.Sub[0])
+	case syntax.OpAlternate:
+		var set [][]rune
+		exact := true
+		for _, sub := range re.Sub {
+			s, ok := literalSuffixes(sub)
+			set = append(set, s...)
+			exact = exact && ok
+		}
+		return set, exact
+	case syntax.OpConcat:
+		return concatSuffixes(re.Sub)
+	}
+
+	return [][]rune{nil}, false
+}
+
+func concatSuffixes(subs []*syntax.Regexp) ([][]rune, bool) {
+	set := [][]rune{nil}
+
+	for i := len(subs) - 1; i >= 0; i-- {
+		prefixes, exact := literalSuffixes(subs[i])
+		if len(prefixes)*len(set) > maxSuffixes {
+			return set, false
+		}
+
+		var next [][]rune
+		for _, p := range prefixes {
+			for _, s := range set {
+				next = append(next, append(append([]rune(nil), p...), s...))
+			}
+		}
+		set = next
+
+		if !exact {
+			return set, false
+		}
+	}
+
+	return set, true
+}
+
+func classSuffixes(re *syntax.Regexp) ([][]rune, bool) {
+	seen := make(map[rune]bool)
+
+	for i := 0; i < len(re.Rune); i += 2 {
+		if re.Rune[i+1]-re.Rune[i] >= maxSuffixes {
+			return [][]rune{nil}, false
+		}
+
+		for r := re.Rune[i]; r <= re.Rune[i+1]; r++ {
+			seen[foldRune(r)] = true
+		}
+
+		if len(seen) > maxSuffixes {
+			return [][]rune{nil}, false
+		}
+	}
+
+	var set [][]rune
+	for r := range seen {
+		set = append(set, []rune{r})
+	}
+
+	return set, true
+}
+
+// foldRune maps every rune of a case-folding orbit (such as 's', 'S' and
+// 'ſ') onto the same key, the smallest rune of the orbit.
+func foldRune(r rune) rune {
+	min := r
+
+	for f := unicode.SimpleFold(r); f != r; f = unicode.SimpleFold(f) {
+		if f < min {
+			min = f
+		}
+	}
+
+	return min
+}
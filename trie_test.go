@@ -0,0 +1,134 @@
+package inflection
+
+import (
+	"strings"
+	"testing"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var sampleWords = []string{
+	"ability", "abilities", "agency", "agencies", "archive", "archives",
+	"axis", "axes", "calf", "calves", "comment", "comments", "crisis",
+	"crises", "day", "days", "diagnosis_a", "diagnosis_as", "dwarf",
+	"dwarves", "experience", "experiences", "foobar", "foobars", "half",
+	"halves", "liquid", "liquids", "movie", "movies", "news", "newsletter",
+	"newsletters", "node_child", "node_children", "old_news", "perspective",
+	"perspectives", "photo", "photos", "product", "products", "query",
+	"queries", "quiz", "quizzes", "safe", "saves", "salesperson",
+	"salespeople", "shelf", "shelves", "spokesman", "spokesmen", "stadium",
+	"stadia", "star", "stars", "stock", "stocks", "user", "users", "chair",
+	"chairs", "price", "prices", "hot_air", "status", "statuses", "alias",
+	"aliases", "vertex", "vertices", "mouse", "mice", "ox", "oxen", "oxens",
+	"box", "boxes", "church", "churches", "cookie", "cookies", "series",
+	"shoe", "shoes", "database", "databases", "hive", "hives", "octopus",
+	"octopi", "virus", "viri", "tomato", "tomatoes", "buffalo", "knife",
+	"bus", "buses", "test", "testis", "testes", "", "s", "x", "ſ", "_",
+	"café", "cafés", "straße", "123", "matrix_matrices",
+}
+
+func corpus() []string {
+	words := append([]string(nil), sampleWords...)
+
+	for _, table := range [][]*Rule{englishIrregulars, englishUncountables} {
+		for _, r := range table {
+			words = append(words, r.singular, r.plural)
+		}
+	}
+
+	var variants []string
+	for _, w := range words {
+		title := w
+		if r, size := utf8.DecodeRuneInString(w); size > 0 {
+			title = string(unicode.ToUpper(r)) + w[size:]
+		}
+		variants = append(variants, w, strings.ToUpper(w), title, "node_"+w, "Node"+title, "mC"+w)
+	}
+
+	return variants
+}
+
+func linearPluralize(in *Inflector, noun string) (plural string) {
+	plural = noun
+
+	for _, r := range in.pluralize {
+		if r.singularRe.MatchString(noun) {
+			plural = restoreCase(noun, r.singularRe.ReplaceAllString(noun, r.plural))
+		}
+	}
+
+	return plural
+}
+
+func linearSingularize(in *Inflector, noun string) (singular string) {
+	singular = noun
+
+	for _, r := range in.singularize {
+		if r.pluralRe.MatchString(noun) {
+			singular = restoreCase(noun, r.pluralRe.ReplaceAllString(noun, r.singular))
+		}
+	}
+
+	return singular
+}
+
+func TestSuffixIndexMatchesLinearScan(t *testing.T) {
+	in := New()
+
+	for _, w := range corpus() {
+		assert.Equal(t, linearPluralize(in, w), in.applyPlurals(w, NoGender), "plural of %q", w)
+		assert.Equal(t, linearSingularize(in, w), in.applySingulars(w, NoGender), "singular of %q", w)
+	}
+}
+
+func TestRequiredSuffixes(t *testing.T) {
+	suffixes := func(pattern string) []string {
+		var set []string
+		for _, s := range requiredSuffixes(pattern) {
+			set = append(set, string(s))
+		}
+		return set
+	}
+
+	assert.Equal(t, []string{"QUIZ"}, suffixes("(?i)(quiz)$"))
+	assert.Equal(t, []string{"OXEN"}, suffixes("^(oxen)$"))
+	assert.ElementsMatch(t, []string{"X", "CH", "SS", "SH"}, suffixes("(?i)(x|ch|ss|sh)$"))
+	assert.ElementsMatch(t, []string{"FE", "LF", "RF"}, suffixes("(?i)(?:([^f])fe|([lr])f)$"))
+	assert.Len(t, suffixes("(?i)([a-z])$"), 26)
+	assert.Equal(t, []string{""}, suffixes("(?i)([^f])$"))
+	assert.Equal(t, []string{""}, suffixes("(?i)^(ox)en"))
+}
+
+func BenchmarkPluralize(b *testing.B) {
+	in, words := New(), corpus()
+
+	for i := 0; i < b.N; i++ {
+		in.applyPlurals(words[i%len(words)], NoGender)
+	}
+}
+
+func BenchmarkPluralizeLinear(b *testing.B) {
+	in, words := New(), corpus()
+
+	for i := 0; i < b.N; i++ {
+		linearPluralize(in, words[i%len(words)])
+	}
+}
+
+func BenchmarkSingularize(b *testing.B) {
+	in, words := New(), corpus()
+
+	for i := 0; i < b.N; i++ {
+		in.applySingulars(words[i%len(words)], NoGender)
+	}
+}
+
+func BenchmarkSingularizeLinear(b *testing.B) {
+	in, words := New(), corpus()
+
+	for i := 0; i < b.N; i++ {
+		linearSingularize(in, words[i%len(words)])
+	}
+}